@@ -0,0 +1,147 @@
+package podcastindex
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// appleID matches the id segment of Apple Podcasts URLs, e.g. "id1441923632"
+var appleID = regexp.MustCompile(`^id(\d+)$`)
+
+// AppleIDFromURL extracts the iTunes id from an Apple Podcasts URL. It accepts
+// web URLs like https://podcasts.apple.com/us/podcast/name/id1441923632, the
+// older itunes.apple.com form and URLs with the id in the query, like
+// https://podcasts.apple.com/podcast?id=1441923632. URLs without a scheme are
+// accepted as well.
+func AppleIDFromURL(appleURL string) (string, bool) {
+	s := strings.TrimSpace(appleURL)
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", false
+	}
+	host := strings.ToLower(u.Hostname())
+	if host != "apple.com" && !strings.HasSuffix(host, ".apple.com") {
+		return "", false
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if m := appleID.FindStringSubmatch(segments[i]); m != nil {
+			return m[1], true
+		}
+	}
+	if id := u.Query().Get("id"); id != "" && strings.Trim(id, "0123456789") == "" {
+		return id, true
+	}
+	return "", false
+}
+
+// PodcastByAppleURL returns the podcast of an Apple Podcasts URL, as users
+// copy it from the Apple Podcasts app or website. ErrInvalidArgument is
+// returned when the URL contains no iTunes id, see AppleIDFromURL.
+func (c *Client) PodcastByAppleURL(ctx context.Context, appleURL string) (*Podcast, error) {
+	id, ok := AppleIDFromURL(appleURL)
+	if !ok {
+		return nil, fmt.Errorf("%w: no iTunes id in %q", ErrInvalidArgument, appleURL)
+	}
+	return c.podcastByITunesID(ctx, id)
+}
+
+// AppleCompat uses the replacements of the iTunes Search API that the index
+// offers outside of its own API, so apps migrating from Apple can keep their
+// models. Create it with Client.AppleCompat.
+type AppleCompat struct {
+	c *Client
+}
+
+// AppleSearchResponse is the response of the iTunes Search API
+type AppleSearchResponse struct {
+	ResultCount int            `json:"resultCount"`
+	Results     []*AppleResult `json:"results"`
+}
+
+// AppleResult is a podcast as the iTunes Search API returns it
+type AppleResult struct {
+	WrapperType            string   `json:"wrapperType"`
+	Kind                   string   `json:"kind"`
+	CollectionID           int64    `json:"collectionId"`
+	TrackID                int64    `json:"trackId"`
+	ArtistName             string   `json:"artistName"`
+	CollectionName         string   `json:"collectionName"`
+	TrackName              string   `json:"trackName"`
+	CollectionCensoredName string   `json:"collectionCensoredName"`
+	TrackCensoredName      string   `json:"trackCensoredName"`
+	CollectionViewURL      string   `json:"collectionViewUrl"`
+	FeedURL                string   `json:"feedUrl"`
+	TrackViewURL           string   `json:"trackViewUrl"`
+	ArtworkURL30           string   `json:"artworkUrl30"`
+	ArtworkURL60           string   `json:"artworkUrl60"`
+	ArtworkURL100          string   `json:"artworkUrl100"`
+	ArtworkURL600          string   `json:"artworkUrl600"`
+	ReleaseDate            string   `json:"releaseDate"`
+	CollectionExplicitness string   `json:"collectionExplicitness"`
+	TrackExplicitness      string   `json:"trackExplicitness"`
+	TrackCount             int      `json:"trackCount"`
+	Country                string   `json:"country"`
+	PrimaryGenreName       string   `json:"primaryGenreName"`
+	ContentAdvisoryRating  string   `json:"contentAdvisoryRating"`
+	GenreIDs               []string `json:"genreIds"`
+	Genres                 []string `json:"genres"`
+}
+
+// AppleCompat returns the client for the iTunes Search API replacements. They
+// are served from the root of the API host, e.g. https://api.podcastindex.org/search,
+// so the version path is removed from the BaseURL of c.
+func (c *Client) AppleCompat() *AppleCompat {
+	config := *c.config
+	config.BaseURL = strings.TrimSuffix(strings.TrimRight(config.BaseURL, "/"), "/api/1.0")
+	client := *c
+	client.config = &config
+	return &AppleCompat{c: &client}
+}
+
+// Search works like https://itunes.apple.com/search?term=, it returns the
+// podcasts matching term. Nothing found is not an error, like with Apple the
+// results are empty then.
+func (a *AppleCompat) Search(term string) (*AppleSearchResponse, error) {
+	return a.SearchCtx(context.Background(), term)
+}
+
+// SearchCtx works like Search, it is canceled when ctx is done
+func (a *AppleCompat) SearchCtx(ctx context.Context, term string) (*AppleSearchResponse, error) {
+	if err := required("term", term); err != nil {
+		return nil, err
+	}
+	return a.get(ctx, a.c.newURL("search").set("term", term).String())
+}
+
+// Lookup works like https://itunes.apple.com/lookup?id=, it returns the podcast
+// with the given iTunes id
+func (a *AppleCompat) Lookup(id string) (*AppleSearchResponse, error) {
+	return a.LookupCtx(context.Background(), id)
+}
+
+// LookupCtx works like Lookup, it is canceled when ctx is done
+func (a *AppleCompat) LookupCtx(ctx context.Context, id string) (*AppleSearchResponse, error) {
+	if err := required("id", id); err != nil {
+		return nil, err
+	}
+	return a.get(ctx, a.c.newURL("lookup").set("id", id).String())
+}
+
+func (a *AppleCompat) get(ctx context.Context, url string) (*AppleSearchResponse, error) {
+	result := &AppleSearchResponse{}
+	err := a.c.requestContext(ctx, url, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Results == nil {
+		result.Results = []*AppleResult{}
+	}
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without making a request while the circuit
+// breaker is open, see WithCircuitBreaker
+var ErrCircuitOpen = errors.New("Circuit breaker is open")
+
+// WithCircuitBreaker makes the client fail fast during outages of the API.
+// After failures requests in a row failed the breaker opens and all requests
+// return ErrCircuitOpen right away for the duration of cooldown. Then a single
+// request is let through: when it succeeds the breaker closes, otherwise it
+// opens again. Only network errors and server errors (status 5xx) count as
+// failures, not found or rate limited responses do not. A failures of 0 or less
+// disables the breaker, which is the default.
+func WithCircuitBreaker(failures int, cooldown time.Duration) ClientOption {
+	return func(c *Client) {
+		if failures <= 0 {
+			c.breaker = nil
+			return
+		}
+		c.breaker = &circuitBreaker{threshold: failures, cooldown: cooldown}
+	}
+}
+
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// allow reports if a request may be made. While the breaker is half open only
+// one request is let through until its result is recorded.
+func (b *circuitBreaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return true
+	}
+	if now.Before(b.openUntil) || b.probing {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// record counts the result of a request that allow let through
+func (b *circuitBreaker) record(err error, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	// a canceled request tells nothing about the API, unlike one that timed out
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	if !isOutage(err) {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = now.Add(b.cooldown)
+	}
+}
+
+// isOutage reports if err means that the API is not working, as opposed to
+// errors of a single request
+func isOutage(err error) bool {
+	var netErr *NetworkError
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode >= http.StatusInternalServerError
+}
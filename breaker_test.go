@@ -0,0 +1,109 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	outage := &NetworkError{Endpoint: "stats/current", Err: errors.New("connection refused")}
+	serverError := &APIError{StatusCode: http.StatusBadGateway}
+	notFound := &APIError{StatusCode: http.StatusOK, Status: "false"}
+	now := time.Now()
+
+	b := &circuitBreaker{threshold: 2, cooldown: time.Minute}
+	b.record(outage, now)
+	if !b.allow(now) {
+		t.Fatal("opened after one failure")
+	}
+	b.record(nil, now)
+	b.record(serverError, now)
+	if !b.allow(now) {
+		t.Fatal("a success did not reset the failures")
+	}
+	b.record(notFound, now)
+	b.record(context.Canceled, now)
+	b.record(outage, now)
+	if !b.allow(now) {
+		t.Fatal("not found or canceled requests counted as failures")
+	}
+	b.record(fmt.Errorf("wrapped: %w", serverError), now)
+	if b.allow(now) || b.allow(now.Add(59*time.Second)) {
+		t.Fatal("not open after two failures in a row")
+	}
+
+	later := now.Add(time.Minute)
+	if !b.allow(later) {
+		t.Fatal("no probe after the cooldown")
+	}
+	if b.allow(later) {
+		t.Fatal("more than one probe while half open")
+	}
+	b.record(&NetworkError{Endpoint: "stats/current", Err: context.DeadlineExceeded}, later)
+	if b.allow(later.Add(time.Second)) {
+		t.Fatal("not open again after the probe timed out")
+	}
+
+	latest := later.Add(time.Minute)
+	if !b.allow(latest) {
+		t.Fatal("no probe after the second cooldown")
+	}
+	b.record(nil, latest)
+	if !b.allow(latest) || !b.allow(latest) {
+		t.Fatal("not closed after a successful probe")
+	}
+}
+
+func TestCircuitBreakerClient(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		respond(http.StatusServiceUnavailable, `{}`)(w, r)
+	}, WithCircuitBreaker(3, time.Minute))
+	for i := 0; i < 3; i++ {
+		if _, err := c.StatsCurrent(); errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("request %d: breaker open too early", i)
+		}
+	}
+	if _, err := c.StatsCurrent(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("got %v, want ErrCircuitOpen", err)
+	}
+	if requests != 3 {
+		t.Errorf("made %d requests, want 3", requests)
+	}
+}
+
+func TestRequestURLLeavesClientAlone(t *testing.T) {
+	requests, hooks, key := 0, 0, ""
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		key = r.Header.Get("X-Auth-Key")
+		respond(http.StatusOK, `{"status":"true","feeds":[]}`)(w, r)
+	},
+		WithCircuitBreaker(3, time.Minute),
+		WithRateLimit(1, 1),
+		WithRequestHook(func(*http.Request) { hooks++ }),
+		WithKeys(RoundRobin, Credentials{Key: "second", Secret: "secret2"}),
+	)
+	for i := 0; i < 5; i++ {
+		if u := c.SearchPodcastsURL("go"); u == "" {
+			t.Fatalf("dry run %d returned no URL", i)
+		}
+	}
+	if hooks != 0 {
+		t.Errorf("dry runs fired %d hooks", hooks)
+	}
+	// the one token of the limiter and the first key are still there
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := c.SearchPodcastsWithOptionsCtx(ctx, "go", SearchOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 || key != "key" {
+		t.Errorf("made %d requests with key %q", requests, key)
+	}
+}
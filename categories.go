@@ -0,0 +1,90 @@
+package podcastindex
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCategoryCacheTTL is how long CachedCategories keeps the categories
+const DefaultCategoryCacheTTL = 24 * time.Hour
+
+type categoryCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	fetched    time.Time
+	categories []*Category
+}
+
+// WithCategoryCacheTTL sets how long CachedCategories keeps the categories
+// before fetching them again
+func WithCategoryCacheTTL(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.categories.ttl = ttl
+	}
+}
+
+// CachedCategories returns all categories like Categories, but only fetches
+// them again once the cached list is older than the configured TTL. It is
+// safe for concurrent use.
+func (c *Client) CachedCategories(ctx context.Context) ([]*Category, error) {
+	cache := c.categories
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if cache.categories != nil && time.Since(cache.fetched) < cache.ttl {
+		return cache.categories, nil
+	}
+	categories, err := c.categoriesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cache.categories = categories
+	cache.fetched = time.Now()
+	return categories, nil
+}
+
+// InvalidateCategories drops the cached categories, the next call to
+// CachedCategories fetches them again
+func (c *Client) InvalidateCategories() {
+	c.categories.mu.Lock()
+	c.categories.categories = nil
+	c.categories.mu.Unlock()
+}
+
+// CategoryID returns the id of the category with the given name, ignoring
+// case. It uses CachedCategories and returns false when the name is unknown
+// or the categories could not be fetched.
+func (c *Client) CategoryID(name string) (int, bool) {
+	return c.CategoryIDCtx(context.Background(), name)
+}
+
+// CategoryIDCtx works like CategoryID, fetching the categories is canceled when
+// ctx is done
+func (c *Client) CategoryIDCtx(ctx context.Context, name string) (int, bool) {
+	categories, err := c.CachedCategories(ctx)
+	if err != nil {
+		return 0, false
+	}
+	for _, category := range categories {
+		if strings.EqualFold(category.Name, name) {
+			return category.ID, true
+		}
+	}
+	return 0, false
+}
+
+// CategoryHistogram counts in how many of the podcasts each category occurs,
+// keyed by category name, e.g. to build filter facets for a search result
+func CategoryHistogram(feeds []*Podcast) map[string]int {
+	histogram := make(map[string]int)
+	for _, p := range feeds {
+		if p == nil {
+			continue
+		}
+		for _, name := range p.Categories {
+			histogram[name]++
+		}
+	}
+	return histogram
+}
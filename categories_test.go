@@ -0,0 +1,68 @@
+package podcastindex
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCategoryID(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		respond(http.StatusOK, `{"status":"true","feeds":[{"id":9,"name":"Business"},{"id":102,"name":"Technology"}]}`)(w, r)
+	})
+	tests := []struct {
+		name string
+		id   int
+		ok   bool
+	}{
+		{"Technology", 102, true},
+		{"technology", 102, true},
+		{"BUSINESS", 9, true},
+		{"Cooking", 0, false},
+	}
+	for _, test := range tests {
+		if id, ok := c.CategoryID(test.name); id != test.id || ok != test.ok {
+			t.Errorf("CategoryID(%q) = %d, %v, want %d, %v", test.name, id, ok, test.id, test.ok)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests, want 1", requests)
+	}
+}
+
+func TestCategoryIDCtxCanceled(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		respond(http.StatusOK, `{"status":"true","feeds":[{"id":102,"name":"Technology"}]}`)(w, r)
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, ok := c.CategoryIDCtx(ctx, "Technology"); ok {
+		t.Error("found the category with a canceled ctx")
+	}
+	if requests != 0 {
+		t.Errorf("made %d requests", requests)
+	}
+}
+
+func TestCategoryHistogram(t *testing.T) {
+	feeds := []*Podcast{
+		{Categories: map[uint]string{102: "Technology", 9: "Business"}},
+		{Categories: map[uint]string{102: "Technology", 55: "News"}},
+		nil,
+		{},
+		{Categories: map[uint]string{102: "Technology", 9: "Business", 55: "News"}},
+	}
+	got := CategoryHistogram(feeds)
+	want := map[string]int{"Technology": 3, "Business": 2, "News": 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := CategoryHistogram(nil); got == nil || len(got) != 0 {
+		t.Errorf("nil: got %#v, want an empty map", got)
+	}
+}
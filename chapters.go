@@ -0,0 +1,88 @@
+package podcastindex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Chapters of an episode in the podcast namespace JSON chapters format
+type Chapters struct {
+	Version  string     `json:"version"`
+	Chapters []*Chapter `json:"chapters"`
+}
+
+// Chapter is a single chapter of an episode, times are in seconds
+type Chapter struct {
+	StartTime float64 `json:"startTime"`
+	EndTime   float64 `json:"endTime,omitempty"`
+	Title     string  `json:"title"`
+	Image     string  `json:"img,omitempty"`
+	URL       string  `json:"url,omitempty"`
+	TOC       *bool   `json:"toc,omitempty"`
+}
+
+// UnmarshalJSON accepts the chapters object as well as a plain list of
+// chapters, because the inlined chapters are not always wrapped
+func (c *Chapters) UnmarshalJSON(s []byte) error {
+	var list []*Chapter
+	if err := json.Unmarshal(s, &list); err == nil {
+		c.Chapters = list
+		return nil
+	}
+	type plain Chapters
+	return json.Unmarshal(s, (*plain)(c))
+}
+
+// EpisodeChapters returns the chapters of an episode. When the API already
+// inlined the chapters they are returned directly, otherwise they are
+// fetched from the ChaptersURL of the episode.
+func (c *Client) EpisodeChapters(e *Episode) (*Chapters, error) {
+	return c.EpisodeChaptersCtx(context.Background(), e)
+}
+
+// EpisodeChaptersCtx works like EpisodeChapters, it is canceled when ctx is
+// done
+func (c *Client) EpisodeChaptersCtx(ctx context.Context, e *Episode) (*Chapters, error) {
+	if e == nil {
+		return nil, fmt.Errorf("%w: episode is nil", ErrInvalidArgument)
+	}
+	if e.Chapters != nil {
+		return e.Chapters, nil
+	}
+	if e.ChaptersURL == "" {
+		return nil, errors.New("Episode has no chapters")
+	}
+	res, err := c.fetch(ctx, e.ChaptersURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.New("Could not fetch chapters: " + res.Status)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	result := &Chapters{}
+	if err := decode(body, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FilterWithChapters returns only the episodes that have chapters, either
+// inlined or as ChaptersURL, see EpisodeChapters
+func FilterWithChapters(episodes []*Episode) []*Episode {
+	result := make([]*Episode, 0, len(episodes))
+	for _, e := range episodes {
+		if e != nil && (e.ChaptersURL != "" || (e.Chapters != nil && len(e.Chapters.Chapters) > 0)) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
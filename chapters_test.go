@@ -0,0 +1,39 @@
+package podcastindex
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFilterWithChapters(t *testing.T) {
+	episodes := []*Episode{
+		{Title: "url", ChaptersURL: "https://example.com/1.json"},
+		{Title: "none"},
+		nil,
+		{Title: "inlined", Chapters: &Chapters{Chapters: []*Chapter{{Title: "Intro"}}}},
+		{Title: "empty inlined", Chapters: &Chapters{}},
+		{Title: "url and inlined", ChaptersURL: "https://example.com/2.json", Chapters: &Chapters{Chapters: []*Chapter{{Title: "Intro"}}}},
+		{Title: "transcript only", TranscriptURL: "https://example.com/3.srt"},
+	}
+	got := titles(FilterWithChapters(episodes))
+	want := []string{"url", "inlined", "url and inlined"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestChaptersUnmarshal(t *testing.T) {
+	for _, in := range []string{
+		`{"version":"1.2.0","chapters":[{"startTime":0,"title":"Intro"}]}`,
+		`[{"startTime":0,"title":"Intro"}]`,
+	} {
+		var c Chapters
+		if err := json.Unmarshal([]byte(in), &c); err != nil {
+			t.Fatalf("%s: %s", in, err)
+		}
+		if len(c.Chapters) != 1 || c.Chapters[0].Title != "Intro" {
+			t.Errorf("%s: got %+v", in, c)
+		}
+	}
+}
@@ -2,11 +2,15 @@ package podcastindex
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"sync"
 	"time"
 )
 
@@ -28,54 +32,421 @@ type Client struct {
 	client *http.Client
 	key    string
 	secret string
+
+	categories *categoryCache
+	server     *serverInfo
+	last       *lastResponse
+	limiter    *rateLimiter
+	retries    int
+	breaker    *circuitBreaker
+	keys       *keyRing
+	logger     *slog.Logger
+
+	requestHooks  []func(*http.Request)
+	responseHooks []func(*http.Response, error)
+
+	emptyResultsNotError bool
+	defaultMax           int
+	truncateDescriptions bool
+	pretty               bool
+	anyWords             bool
+	strictDecoding       bool
+	value                ValueType
+}
+
+// ClientOption changes the configuration of a client created with NewClient
+type ClientOption func(*Client)
+
+// NewClient creates an API client with the default configuration, which can be
+// changed with opts
+func NewClient(apiKey, apiSecret string, opts ...ClientOption) *Client {
+	c := NewClientWithConfig(apiKey, apiSecret, *DefaultConfig, http.DefaultClient)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithHTTPClient sets the http.Client used for all requests, e.g. one with
+// instrumentation or TLS settings. Options that change the http.Client, like
+// WithTransport or WithTimeout, change a copy of it, so they have to come after
+// WithHTTPClient. A nil client is ignored.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(c *Client) {
+		if client != nil {
+			c.client = client
+		}
+	}
+}
+
+// WithBaseURL sets the URL the paths of the API endpoints are appended to,
+// instead of BaseURL, e.g. for a mock server, a caching proxy or a mirror of
+// the index. A trailing slash is optional. The base should end with the
+// version path like BaseURL does, AppleCompat removes a trailing "/api/1.0"
+// to get to the root of the host.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.config.BaseURL = baseURL
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request, instead of
+// UserAgent. The API asks to identify the app with it.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.config.UserAgent = userAgent
+	}
+}
+
+// WithTimeout limits the time of each request including reading the response,
+// see http.Client.Timeout. By default there is no timeout, so a context with a
+// deadline should be used with the Ctx methods.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		client := *c.client
+		client.Timeout = timeout
+		c.client = &client
+	}
+}
+
+// WithTransport sets the http.RoundTripper used for all requests
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		c.setTransport(transport)
+	}
+}
+
+// WithProxy sends all requests through the proxy at proxyURL. Supported
+// schemes are http, https and socks5. An error is returned when proxyURL is
+// malformed.
+//
+// The proxy is set on a copy of the current *http.Transport of the client, so
+// WithProxy has to come after WithTransport to proxy that transport. A
+// WithTransport after WithProxy replaces the transport including the proxy, and
+// transports which are not an *http.Transport are left untouched.
+func WithProxy(proxyURL string) (ClientOption, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("Unsupported proxy scheme %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("Proxy URL %q has no host", proxyURL)
+	}
+	return func(c *Client) {
+		var transport *http.Transport
+		switch t := c.client.Transport.(type) {
+		case nil:
+			transport = http.DefaultTransport.(*http.Transport).Clone()
+		case *http.Transport:
+			transport = t.Clone()
+		default:
+			return
+		}
+		transport.Proxy = http.ProxyURL(u)
+		c.setTransport(transport)
+	}, nil
+}
+
+// WithEmptyResultsNotError changes how list endpoints, e.g. searches or
+// EpisodesByFeedID, report that nothing was found. By default an error is
+// returned, with this option set they return an empty list and no error
+// instead. Endpoints which return a single item, like PodcastByFeedID or
+// EpisodeByID, still return an error.
+func WithEmptyResultsNotError(empty bool) ClientOption {
+	return func(c *Client) {
+		c.emptyResultsNotError = empty
+	}
+}
+
+// WithDefaultMax sets the number of results requested when a method is called
+// with max 0, instead of leaving it to the API default. A max other than 0 still
+// wins. The API limits of each endpoint still apply, so the number of results
+// can be lower than n.
+func WithDefaultMax(n int) ClientOption {
+	return func(c *Client) {
+		c.defaultMax = n
+	}
+}
+
+// WithFullText sets if descriptions are returned in full, which is the default.
+// When it is false the API truncates descriptions to 100 characters.
+func WithFullText(fullText bool) ClientOption {
+	return func(c *Client) {
+		c.truncateDescriptions = !fullText
+	}
+}
+
+// WithPretty makes the API return indented JSON, which helps when looking at
+// captured responses
+func WithPretty(pretty bool) ClientOption {
+	return func(c *Client) {
+		c.pretty = pretty
+	}
+}
+
+// WithExactPhraseSearch sets if search terms are sent in quotes, which is the
+// default. Quoted terms only match the exact phrase, which gives few but
+// relevant results. Without quotes the API matches the words of the term
+// independently, which finds more podcasts and episodes that are less relevant.
+// It applies to all podcast and episode searches.
+func WithExactPhraseSearch(exact bool) ClientOption {
+	return func(c *Client) {
+		c.anyWords = !exact
+	}
+}
+
+// WithStrictDecoding makes requests fail when the API returns fields that are
+// not part of the result types, instead of ignoring them. This helps to notice
+// when the API adds fields, but every addition to the API breaks the client
+// until this package models the field, so it is off by default.
+func WithStrictDecoding(strict bool) ClientOption {
+	return func(c *Client) {
+		c.strictDecoding = strict
+	}
+}
+
+// WithValue makes podcast searches and PodcastsTrending only return feeds with
+// a value block of the given type, e.g. ValueLightning for feeds that can be
+// paid with streaming sats. An empty type returns all feeds, which is the
+// default.
+func WithValue(valueType ValueType) ClientOption {
+	return func(c *Client) {
+		c.value = valueType
+	}
 }
 
-// NewClient creates an API client with the default configuration
-func NewClient(apiKey, apiSecret string) *Client {
-	return NewClientWithConfig(apiKey, apiSecret, *DefaultConfig, http.DefaultClient)
+// defaultRetryAfter is the time waited before retrying a request for which the
+// API sent status 429 without a Retry-After header
+const defaultRetryAfter = time.Second
+
+// WithRetries retries requests for which the API answered with status 429 up to
+// n times, after waiting for the Retry-After the API sent, or one second when
+// it sent none. The wait ends early with the error of the context when it is
+// done. By default requests are not retried and ErrRateLimited is returned.
+func WithRetries(n int) ClientOption {
+	return func(c *Client) {
+		c.retries = n
+	}
+}
+
+// listNotFound returns the error of a list endpoint for which the API replied
+// with status false, or nil when WithEmptyResultsNotError is set
+func (c *Client) listNotFound(endpoint, description string, notFound error) error {
+	if c.emptyResultsNotError {
+		return nil
+	}
+	return newStatusError(endpoint, description, notFound)
+}
+
+// setTransport replaces the transport on a copy of the http.Client, because
+// the client might be shared, e.g. http.DefaultClient
+func (c *Client) setTransport(transport http.RoundTripper) {
+	client := *c.client
+	client.Transport = transport
+	c.client = &client
 }
 
-// NewClientWithConfig creates an API client with an custom configuration
+// NewClientWithConfig creates an API client with an custom configuration. The
+// client is used for all requests, e.g. to add instrumentation, proxies or TLS
+// settings, nil uses http.DefaultClient.
 func NewClientWithConfig(apiKey, apiSecret string, config Config, client *http.Client) *Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
 	return &Client{
 		key:    apiKey,
 		secret: apiSecret,
 		config: &config,
 		client: client,
+		categories: &categoryCache{
+			ttl: DefaultCategoryCacheTTL,
+		},
+		server: &serverInfo{},
+		last:   &lastResponse{},
 	}
 }
 
 func (c *Client) request(url string, result interface{}) error {
-	u := fmt.Sprintf("%s%s", c.config.BaseURL, url)
-	req, err := http.NewRequest(http.MethodGet, u, nil)
+	return c.requestContext(context.Background(), url, result)
+}
+
+func (c *Client) requestContext(ctx context.Context, url string, result interface{}) error {
+	ctx, span := startSpan(ctx, endpointOf(url))
+	status, err := c.do(ctx, url, result)
+	for attempt := 0; attempt < c.retries && errors.Is(err, ErrRateLimited); attempt++ {
+		if err = wait(ctx, retryAfter(err)); err != nil {
+			break
+		}
+		status, err = c.do(ctx, url, result)
+	}
+	span.End(status, err)
+	return err
+}
+
+// retryAfter returns the time to wait before retrying after err
+func retryAfter(err error) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter
+	}
+	return defaultRetryAfter
+}
+
+// wait sleeps for d or until ctx is done, then it returns the error of ctx
+func wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// do makes a single request to the API and decodes the response into result.
+// It returns the HTTP status, which is 0 when there was no response.
+func (c *Client) do(ctx context.Context, url string, result interface{}) (int, error) {
+	if c.breaker == nil {
+		return c.authorized(ctx, url, result)
+	}
+	if !c.breaker.allow(time.Now()) {
+		return 0, ErrCircuitOpen
+	}
+	status, err := c.authorized(ctx, url, result)
+	c.breaker.record(err, time.Now())
+	return status, err
+}
+
+// authorized sends the request with the credentials of the client, when the API
+// rejects a key of WithKeys the request is sent again with the next key
+func (c *Client) authorized(ctx context.Context, url string, result interface{}) (int, error) {
+	creds, rejected := c.credentials()
+	status, err := c.send(ctx, url, result, creds)
+	for rejected(err) {
+		creds, rejected = c.credentials()
+		status, err = c.send(ctx, url, result, creds)
+	}
+	return status, err
+}
+
+// send makes the request of do
+func (c *Client) send(ctx context.Context, url string, result interface{}, creds Credentials) (status int, err error) {
+	var header http.Header
+	if c.logger != nil {
+		start := time.Now()
+		defer func() {
+			c.logRequest(ctx, url, start, status, header, err)
+		}()
+	}
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx); err != nil {
+			return 0, err
+		}
+	}
+	u := joinURL(c.config.BaseURL, url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	now := time.Now()
-	auth := generateAuthorizationHeader(c.key, c.secret, now)
+	auth := generateAuthorizationHeader(creds.Key, creds.Secret, now)
 	req.Header.Set("User-Agent", c.config.UserAgent)
 	req.Header.Set("X-Auth-Date", fmt.Sprintf("%d", now.Unix()))
-	req.Header.Set("X-Auth-Key", c.key)
+	req.Header.Set("X-Auth-Key", creds.Key)
 	req.Header.Set("Authorization", auth)
+	c.onRequest(req)
 
 	res, err := c.client.Do(req)
 	if err != nil {
-		return err
+		c.onResponse(nil, nil, err)
+		return 0, &NetworkError{Endpoint: endpointOf(url), Err: err}
 	}
+	header = res.Header
 	if res.Body != nil {
 		defer res.Body.Close()
 	}
 	if res.Body == nil {
-		return errors.New("API didn't returned a response")
+		err := errors.New("API didn't returned a response")
+		c.onResponse(res, nil, err)
+		return res.StatusCode, err
 	}
+	c.server.record(res.Header)
+	c.last.record(res.Header)
 	resBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return err
+		c.onResponse(res, nil, err)
+		return res.StatusCode, &NetworkError{Endpoint: endpointOf(url), Err: err}
+	}
+	c.onResponse(res, resBody, nil)
+	captureRaw(ctx, url, res.StatusCode, resBody)
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, newAPIError(res, url, resBody)
+	}
+	if err := c.decodeBody(resBody, result); err != nil {
+		return res.StatusCode, newDecodeError(url, resBody, err)
+	}
+	return res.StatusCode, nil
+}
+
+// decodeBody decodes in into out, strictly when configured, see
+// WithStrictDecoding
+func (c *Client) decodeBody(in []byte, out interface{}) error {
+	if c.strictDecoding {
+		return decodeStrict(in, out)
+	}
+	return decode(in, out)
+}
+
+// lastResponse keeps the headers of the last response of the API
+type lastResponse struct {
+	mu     sync.Mutex
+	header http.Header
+}
+
+func (l *lastResponse) record(header http.Header) {
+	l.mu.Lock()
+	l.header = header.Clone()
+	l.mu.Unlock()
+}
+
+// LastResponseHeaders returns a copy of the headers of the last response of the
+// API, e.g. to look at rate limit headers. With concurrent requests it is the
+// response that completed last. Resources outside of the API, like enclosures,
+// are not recorded. It returns nil before the first response.
+func (c *Client) LastResponseHeaders() http.Header {
+	c.last.mu.Lock()
+	defer c.last.mu.Unlock()
+	return c.last.header.Clone()
+}
+
+// fetch requests a resource outside of the API, e.g. a chapters file or an
+// enclosure, with the http.Client of c but without the authentication headers
+func (c *Client) fetch(ctx context.Context, url string, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
 	}
-	return decode(resBody, result)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("User-Agent", c.config.UserAgent)
+	return c.client.Do(req)
 }
 
 func decode(in []byte, out interface{}) error {
 	decoder := json.NewDecoder(bytes.NewReader(in))
 	return decoder.Decode(out)
 }
+
+// decodeStrict fails on fields that out does not have
+func decodeStrict(in []byte, out interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(in))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(out)
+}
@@ -0,0 +1,79 @@
+package podcastindex
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newTestClient returns a client that sends its requests to handler
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...ClientOption) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewClient("key", "secret", append([]ClientOption{WithBaseURL(server.URL)}, opts...)...)
+}
+
+// respond returns a handler that answers every request with status and body
+func respond(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}
+}
+
+func TestAuthHeaders(t *testing.T) {
+	var header http.Header
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		respond(http.StatusOK, `{"status":"true","feeds":[]}`)(w, r)
+	})
+	if _, err := c.SearchPodcasts("go"); err != nil {
+		t.Fatal(err)
+	}
+	if got := header.Get("X-Auth-Key"); got != "key" {
+		t.Errorf("X-Auth-Key = %q", got)
+	}
+	if header.Get("X-Auth-Date") == "" || header.Get("Authorization") == "" {
+		t.Errorf("missing auth headers: %v", header)
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	body := `{"status":"true","feed":{"id":1,"newField":"surprise"}}`
+	tests := []struct {
+		name   string
+		strict bool
+	}{
+		{"default ignores unknown fields", false},
+		{"strict", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := newTestClient(t, respond(http.StatusOK, body), WithStrictDecoding(test.strict))
+			p, err := c.PodcastByFeedID("1")
+			if !test.strict {
+				if err != nil || p.ID != 1 {
+					t.Errorf("got %+v, %v", p, err)
+				}
+				return
+			}
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) || !strings.Contains(decodeErr.Err.Error(), "newField") {
+				t.Errorf("got %v, want a DecodeError for newField", err)
+			}
+		})
+	}
+}
+
+func TestStrictDecodingTopLevel(t *testing.T) {
+	c := newTestClient(t, respond(http.StatusOK, `{"status":"true","feeds":[],"extra":1}`), WithStrictDecoding(true))
+	_, err := c.SearchPodcasts("go")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("got %v, want a DecodeError", err)
+	}
+}
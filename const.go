@@ -5,4 +5,6 @@ const (
 	UserAgent = "go-podcastindex-library"
 	// BaseURL for the API
 	BaseURL = "https://api.podcastindex.org/api/1.0/"
+	// WebURL of the podcastindex website, used for share links
+	WebURL = "https://podcastindex.org/"
 )
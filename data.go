@@ -20,6 +20,7 @@ type PodcastResult struct {
 // Podcast contains all informations about a podcast returned from the podcastindex API
 type Podcast struct {
 	ID                     uint            `json:"id"`
+	PodcastGUID            string          `json:"podcastGuid"`
 	Title                  string          `json:"title"`
 	URL                    string          `json:"url"`
 	OriginalURL            string          `json:"originalUrl"`
@@ -29,6 +30,7 @@ type Podcast struct {
 	OwnerName              string          `json:"ownerName"`
 	Image                  string          `json:"image"`
 	Artwork                string          `json:"artwork"`
+	ImageURLHash           uint32          `json:"imageUrlHash"`
 	LastUpdateTime         Time            `json:"lastUpdateTime"`
 	LastCrawlTime          Time            `json:"lastCrawlTime"`
 	LastParseTime          Time            `json:"lastParseTime"`
@@ -40,19 +42,41 @@ type Podcast struct {
 	Language               string          `json:"language"`
 	Type                   int             `json:"type"`
 	Dead                   int             `json:"dead"`
-	EpisodeCount		   int 			   `json:"episodeCount"`
+	Locked                 int             `json:"locked"`
+	EpisodeCount           int             `json:"episodeCount"`
 	CrawlErrors            int             `json:"crawlErrors"`
 	ParseErrors            int             `json:"parseErrors"`
 	Categories             map[uint]string `json:"categories"`
+	Txt                    []*TxtRecord    `json:"txt"`
+	Persons                []*Person       `json:"persons"`
+	Medium                 Medium          `json:"medium"`
+}
+
+// TxtRecord is a <podcast:txt> tag of a feed, e.g. to verify the ownership of
+// a feed. Purpose is empty when the tag has none.
+type TxtRecord struct {
+	Purpose string `json:"purpose"`
+	Value   string `json:"value"`
 }
 
 type EpisodeArrayResponse struct {
-	Status      string     `json:"status"`
-	Items       []*Episode `json:"items"`
+	Status string     `json:"status"`
+	Items  []*Episode `json:"items"`
+	// LiveItems are the live items of the feed, which episodes/byfeedid lists
+	// apart from the items
+	LiveItems   []*Episode `json:"liveItems,omitempty"`
 	Count       int        `json:"count"`
 	Description string     `json:"description"`
 }
 
+type LiveEpisodesResponse struct {
+	Status      string      `json:"status"`
+	Items       []*Episode  `json:"items"`
+	Count       int         `json:"count"`
+	Max         interface{} `json:"max"`
+	Description string      `json:"description"`
+}
+
 type RandomEpisodesResponse struct {
 	Status      string     `json:"status"`
 	Items       []*Episode `json:"episodes"`
@@ -70,28 +94,36 @@ type EpisodeResponse struct {
 // Episode contains all information about a single podcast episode returned from
 // the podcastindex API
 type Episode struct {
-	ID              int      `json:"id"`
-	Title           string   `json:"title"`
-	Link            string   `json:"link"`
-	Description     string   `json:"description"`
-	GUID            string   `json:"guid"`
-	DatePublished   Time     `json:"datePublished"`
-	DateCrawled     Time     `json:"dateCrawled"`
-	EnclosureURL    string   `json:"enclosureUrl"`
-	EnclosureType   string   `json:"enclosureType"`
-	EnclosureLength int      `json:"enclosureLength"`
-	Duration        Duration `json:"duration"`
-	Explicit        int      `json:"explicit"`
-	Episode         int      `json:"episode"`
-	EpisodeType     string   `json:"episodeType"`
-	Season          int      `json:"season"`
-	Image           string   `json:"image"`
-	FeedItunesID    int      `json:"feedItunesId"`
-	FeedImage       string   `json:"feedImage"`
-	FeedID          int      `json:"feedId"`
-	FeedLanguage    string   `json:"feedLanguage"`
-	ChaptersURL     string   `json:"chaptersUrl"`
-	TranscriptURL   string   `json:"transcriptUrl"`
+	ID              int           `json:"id"`
+	Title           string        `json:"title"`
+	Link            string        `json:"link"`
+	Description     string        `json:"description"`
+	GUID            string        `json:"guid"`
+	DatePublished   Time          `json:"datePublished"`
+	DateCrawled     Time          `json:"dateCrawled"`
+	EnclosureURL    string        `json:"enclosureUrl"`
+	EnclosureType   string        `json:"enclosureType"`
+	EnclosureLength int           `json:"enclosureLength"`
+	Duration        Duration      `json:"duration"`
+	Explicit        int           `json:"explicit"`
+	Episode         int           `json:"episode"`
+	EpisodeType     string        `json:"episodeType"`
+	Season          int           `json:"season"`
+	Image           string        `json:"image"`
+	FeedItunesID    int           `json:"feedItunesId"`
+	FeedImage       string        `json:"feedImage"`
+	FeedID          int           `json:"feedId"`
+	FeedLanguage    string        `json:"feedLanguage"`
+	ChaptersURL     string        `json:"chaptersUrl"`
+	Chapters        *Chapters     `json:"chapters"`
+	TranscriptURL   string        `json:"transcriptUrl"`
+	Transcripts     []*Transcript `json:"transcripts"`
+	Soundbites      []*Soundbite  `json:"soundbites"`
+	Persons         []*Person     `json:"persons"`
+	StartTime       Time          `json:"startTime"`
+	EndTime         Time          `json:"endTime"`
+	LiveStatus      string        `json:"status"`
+	ContentLink     string        `json:"contentLink"`
 }
 
 type RecentPodcastsResponse struct {
@@ -135,11 +167,15 @@ type NewPodcast struct {
 }
 
 type CategoryArrayResponse struct {
-	Status string      `json:"status"`
-	Count  int         `json:"count"`
-	Feeds  []*Category `json:"feeds"`
+	Status      string      `json:"status"`
+	Count       int         `json:"count"`
+	Feeds       []*Category `json:"feeds"`
+	Description string      `json:"description"`
 }
 
+// Category of the podcast index. The category filters, e.g. of RecentPodcasts,
+// accept the ID as well as the Name, the ID is more reliable because names can
+// change.
 type Category struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
@@ -154,9 +190,38 @@ type PodcastsTrendingResponse struct {
 	Description string      `json:"description"`
 }
 
+type PodcastsByMediumResponse struct {
+	Status      string     `json:"status"`
+	Feeds       []*Podcast `json:"feeds"`
+	Count       int        `json:"count"`
+	Medium      Medium     `json:"medium"`
+	Description string     `json:"description"`
+}
+
+type DeadPodcastsResponse struct {
+	Status      string         `json:"status"`
+	Feeds       []*DeadPodcast `json:"feeds"`
+	Count       int            `json:"count"`
+	Description string         `json:"description"`
+}
+
+// DeadPodcast is a feed that was marked as dead in the index. DuplicateOf is
+// the id of the feed it duplicates, if it was marked dead for that reason.
+type DeadPodcast struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	DuplicateOf int    `json:"duplicateOf"`
+}
+
 type AddByFeedURLResponse struct {
 	Status      string `json:"status"`
 	FeedId      int    `json:"feedId"`
 	Existed     bool   `json:"existed"`
 	Description string `json:"description"`
 }
+
+type PubNotifyResponse struct {
+	Status      string `json:"status"`
+	Description string `json:"description"`
+}
@@ -3,6 +3,7 @@ package podcastindex
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -15,8 +16,11 @@ func (t Time) MarshalJSON() ([]byte, error) {
 	return []byte(strconv.FormatInt(time.Time(t).Unix(), 10)), nil
 }
 
-// UnmarshalJSON is used to convert the timestamp from JSON
+// UnmarshalJSON is used to convert the timestamp from JSON, null is ignored
 func (t *Time) UnmarshalJSON(s []byte) (err error) {
+	if string(s) == "null" {
+		return nil
+	}
 	u, err := strconv.ParseInt(string(s), 10, 64)
 	if err != nil {
 		return err
@@ -53,3 +57,23 @@ func (d *Duration) UnmarshalJSON(s []byte) (err error) {
 func (d Duration) String() string {
 	return time.Duration(d).String()
 }
+
+// prettyDateLayout is the format of the *Pretty date fields of the API, e.g.
+// "November 7, 2020 10:00am"
+const prettyDateLayout = "January 2, 2006 3:04pm"
+
+// ParsePrettyDate parses a date in the format of the *Pretty fields of the API,
+// like datePublishedPretty: "November 7, 2020 10:00am". AM/PM in upper case and
+// a space before it are accepted as well. The string carries no timezone, so
+// the result is in UTC. Prefer the unix timestamp fields where available.
+func ParsePrettyDate(s string) (time.Time, error) {
+	s = strings.Join(strings.Fields(s), " ")
+	lower := strings.ToLower(s)
+	if strings.HasSuffix(lower, " am") || strings.HasSuffix(lower, " pm") {
+		s = s[:len(s)-3] + s[len(s)-2:]
+	}
+	if len(s) >= 2 {
+		s = s[:len(s)-2] + strings.ToLower(s[len(s)-2:])
+	}
+	return time.ParseInLocation(prettyDateLayout, s, time.UTC)
+}
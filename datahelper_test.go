@@ -0,0 +1,44 @@
+package podcastindex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePrettyDate(t *testing.T) {
+	want := time.Date(2020, time.November, 7, 10, 0, 0, 0, time.UTC)
+	evening := time.Date(2020, time.November, 7, 22, 5, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"November 7, 2020 10:00am", want},
+		{"November 7, 2020 10:00AM", want},
+		{"November 7, 2020 10:00 am", want},
+		{"November 7, 2020 10:00 AM", want},
+		{"  November 7,  2020 10:00am ", want},
+		{"November 7, 2020 10:05pm", evening},
+		{"November 7, 2020 10:05PM", evening},
+		{"November 7, 2020 10:05 Pm", evening},
+		{"November 7, 2020 12:00am", time.Date(2020, time.November, 7, 0, 0, 0, 0, time.UTC)},
+		{"November 7, 2020 12:00pm", time.Date(2020, time.November, 7, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		got, err := ParsePrettyDate(test.in)
+		if err != nil {
+			t.Errorf("ParsePrettyDate(%q): %s", test.in, err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("ParsePrettyDate(%q) = %s, want %s", test.in, got, test.want)
+		}
+	}
+}
+
+func TestParsePrettyDateInvalid(t *testing.T) {
+	for _, in := range []string{"", "a", "November 7, 2020", "2020-11-07 10:00", "November 7, 2020 13:00pm"} {
+		if _, err := ParsePrettyDate(in); err == nil {
+			t.Errorf("ParsePrettyDate(%q) returned no error", in)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package podcastindex
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// secondLevelSuffixes are public suffixes with two labels that are common for
+// podcast hosts. It is not the complete public suffix list, but avoids the
+// dependency for the cases that matter here.
+var secondLevelSuffixes = map[string]bool{
+	"co.uk": true, "org.uk": true, "ac.uk": true, "gov.uk": true,
+	"com.au": true, "net.au": true, "org.au": true,
+	"co.nz": true, "org.nz": true,
+	"co.jp": true, "ne.jp": true, "or.jp": true,
+	"com.br": true, "com.mx": true, "com.ar": true, "com.tr": true,
+	"co.za": true, "co.in": true, "co.kr": true, "com.cn": true,
+	"com.sg": true, "com.hk": true, "com.tw": true,
+}
+
+// HostDomain returns the registrable domain of the enclosure URL, e.g.
+// "libsyn.com" for "https://traffic.libsyn.com/...", to group episodes by
+// their hosting provider. IP addresses are returned as they are. It returns an
+// empty string when the URL has no host.
+func (e *Episode) HostDomain() string {
+	u, err := url.Parse(e.EnclosureURL)
+	if err != nil {
+		return ""
+	}
+	return registrableDomain(u.Hostname())
+}
+
+func registrableDomain(host string) string {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "" || net.ParseIP(host) != nil {
+		return host
+	}
+	labels := strings.Split(host, ".")
+	n := 2
+	if len(labels) >= 3 && secondLevelSuffixes[strings.Join(labels[len(labels)-2:], ".")] {
+		n = 3
+	}
+	if len(labels) <= n {
+		return host
+	}
+	return strings.Join(labels[len(labels)-n:], ".")
+}
@@ -0,0 +1,30 @@
+package podcastindex
+
+import "testing"
+
+func TestHostDomain(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://traffic.libsyn.com/secure/show/ep1.mp3", "libsyn.com"},
+		{"https://dts.podtrac.com/redirect.mp3/chtbl.com/track/ep.mp3", "podtrac.com"},
+		{"https://anchor.fm/s/1234/podcast/play/1.m4a", "anchor.fm"},
+		{"https://media.BBC.co.uk/podcasts/ep.mp3", "bbc.co.uk"},
+		{"https://audio.abc.net.au/ep.mp3", "abc.net.au"},
+		{"https://co.uk/ep.mp3", "co.uk"},
+		{"https://example.com./ep.mp3", "example.com"},
+		{"http://localhost:8080/ep.mp3", "localhost"},
+		{"http://192.168.1.10:8000/ep.mp3", "192.168.1.10"},
+		{"http://[::1]/ep.mp3", "::1"},
+		{"/relative/ep.mp3", ""},
+		{"", ""},
+		{"http://%zz", ""},
+	}
+	for _, test := range tests {
+		e := &Episode{EnclosureURL: test.url}
+		if got := e.HostDomain(); got != test.want {
+			t.Errorf("HostDomain(%q) = %q, want %q", test.url, got, test.want)
+		}
+	}
+}
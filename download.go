@@ -0,0 +1,96 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// DownloadEpisode downloads the enclosure of e and writes it to w. When
+// progress is not nil it is called after every write with the number of bytes
+// written so far and the total size, total is -1 when the size is not known.
+// The download stops when ctx is cancelled.
+func (c *Client) DownloadEpisode(ctx context.Context, e *Episode, w io.Writer, progress func(bytesWritten, total int64)) error {
+	return c.DownloadEpisodeFrom(ctx, e, w, 0, progress)
+}
+
+// DownloadEpisodeFrom works like DownloadEpisode, but resumes a download at the
+// byte offset start using a Range request. Only the bytes from start on are
+// written to w, progress reports bytes including start. When the server does
+// not support ranges the skipped part is downloaded and discarded.
+func (c *Client) DownloadEpisodeFrom(ctx context.Context, e *Episode, w io.Writer, start int64, progress func(bytesWritten, total int64)) error {
+	if e == nil {
+		return fmt.Errorf("%w: episode is nil", ErrInvalidArgument)
+	}
+	if e.EnclosureURL == "" {
+		return errors.New("Episode has no enclosure")
+	}
+	header := http.Header{}
+	if start > 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-", start))
+	}
+	res, err := c.fetch(ctx, e.EnclosureURL, header)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	total := int64(-1)
+	switch res.StatusCode {
+	case http.StatusOK:
+		if res.ContentLength >= 0 {
+			total = res.ContentLength
+		}
+		if start > 0 {
+			if _, err := io.CopyN(io.Discard, res.Body, start); err != nil {
+				return err
+			}
+		}
+	case http.StatusPartialContent:
+		total = contentRangeTotal(res.Header.Get("Content-Range"))
+		if total < 0 && res.ContentLength >= 0 {
+			total = start + res.ContentLength
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		return fmt.Errorf("Could not resume download at byte %d", start)
+	default:
+		return errors.New("Could not download episode: " + res.Status)
+	}
+
+	_, err = io.Copy(&progressWriter{w: w, written: start, total: total, progress: progress}, res.Body)
+	return err
+}
+
+// contentRangeTotal returns the complete length from a header like
+// "bytes 200-1000/1001", or -1 when it is unknown
+func contentRangeTotal(header string) int64 {
+	i := strings.LastIndex(header, "/")
+	if i < 0 {
+		return -1
+	}
+	total, err := strconv.ParseInt(header[i+1:], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return total
+}
+
+type progressWriter struct {
+	w        io.Writer
+	written  int64
+	total    int64
+	progress func(bytesWritten, total int64)
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	if p.progress != nil {
+		p.progress(p.written, p.total)
+	}
+	return n, err
+}
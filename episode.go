@@ -0,0 +1,130 @@
+package podcastindex
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// mediaTypes which are playable besides audio/* and video/*
+var mediaTypes = map[string]bool{
+	"application/ogg":               true,
+	"application/x-mpegurl":         true,
+	"application/vnd.apple.mpegurl": true,
+}
+
+// PlayableURL returns the enclosure URL of the episode and if it can be
+// played. The URL has to be an absolute http(s) URL and the enclosure type,
+// when set, has to be an audio or video type.
+func (e *Episode) PlayableURL() (string, bool) {
+	if e.EnclosureURL == "" {
+		return "", false
+	}
+	u, err := url.Parse(e.EnclosureURL)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return e.EnclosureURL, false
+	}
+	if e.EnclosureType == "" {
+		return e.EnclosureURL, true
+	}
+	t := strings.ToLower(strings.TrimSpace(strings.SplitN(e.EnclosureType, ";", 2)[0]))
+	playable := strings.HasPrefix(t, "audio/") || strings.HasPrefix(t, "video/") || mediaTypes[t]
+	return e.EnclosureURL, playable
+}
+
+// PlayableEpisodes returns only the episodes that have a playable enclosure,
+// see PlayableURL
+func PlayableEpisodes(episodes []*Episode) []*Episode {
+	result := make([]*Episode, 0, len(episodes))
+	for _, e := range episodes {
+		if e == nil {
+			continue
+		}
+		if _, ok := e.PlayableURL(); ok {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// ShareURL returns a web page for the episode. The page of the publisher (Link)
+// is preferred, then the page on podcastindex.org and at last the enclosure.
+func (e *Episode) ShareURL() string {
+	if e.Link != "" {
+		return e.Link
+	}
+	if e.FeedID != 0 && e.ID != 0 {
+		return fmt.Sprintf("%spodcast/%d?episode=%d", WebURL, e.FeedID, e.ID)
+	}
+	return e.EnclosureURL
+}
+
+// PlainDescription returns the description without HTML tags and with decoded
+// entities, e.g. for previews
+func (e *Episode) PlainDescription() string {
+	return stripHTML(e.Description)
+}
+
+// GroupByPublishDay groups the episodes by the day they were published in loc,
+// keyed by "YYYY-MM-DD". The order of the episodes is kept within each day. A
+// nil loc means UTC.
+func GroupByPublishDay(episodes []*Episode, loc *time.Location) map[string][]*Episode {
+	if loc == nil {
+		loc = time.UTC
+	}
+	days := make(map[string][]*Episode)
+	for _, e := range episodes {
+		if e == nil {
+			continue
+		}
+		day := time.Time(e.DatePublished).In(loc).Format("2006-01-02")
+		days[day] = append(days[day], e)
+	}
+	return days
+}
+
+// TotalDuration returns the summed up duration of the episodes
+func TotalDuration(episodes []*Episode) time.Duration {
+	var total time.Duration
+	for _, e := range episodes {
+		if e != nil {
+			total += time.Duration(e.Duration)
+		}
+	}
+	return total
+}
+
+// DedupeEpisodesByGUID removes episodes that are in the list more than once,
+// e.g. after merging the episodes of feeds that were listed twice. Episodes are
+// the same when they have the same feed id and GUID, episodes without GUID
+// are compared by their feed id and id. Episodes without GUID and id are always
+// kept. The first occurrence is kept and the order is not changed.
+func DedupeEpisodesByGUID(episodes []*Episode) []*Episode {
+	type key struct {
+		feedID int
+		guid   string
+		id     int
+	}
+	seen := make(map[key]bool, len(episodes))
+	result := make([]*Episode, 0, len(episodes))
+	for _, e := range episodes {
+		if e == nil {
+			continue
+		}
+		k := key{feedID: e.FeedID, guid: e.GUID}
+		if e.GUID == "" {
+			if e.ID == 0 {
+				result = append(result, e)
+				continue
+			}
+			k = key{feedID: e.FeedID, id: e.ID}
+		}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		result = append(result, e)
+	}
+	return result
+}
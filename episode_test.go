@@ -0,0 +1,115 @@
+package podcastindex
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func titles(episodes []*Episode) []string {
+	result := make([]string, 0, len(episodes))
+	for _, e := range episodes {
+		result = append(result, e.Title)
+	}
+	return result
+}
+
+func TestDedupeEpisodesByGUID(t *testing.T) {
+	episodes := []*Episode{
+		{Title: "a", FeedID: 1, GUID: "x", ID: 10},
+		{Title: "b", FeedID: 2, GUID: "x", ID: 11},
+		nil,
+		{Title: "a again", FeedID: 1, GUID: "x", ID: 12},
+		{Title: "c", FeedID: 1, ID: 20},
+		{Title: "c again", FeedID: 1, ID: 20},
+		{Title: "c other feed", FeedID: 2, ID: 20},
+		{Title: "no id", FeedID: 1},
+		{Title: "no id other feed", FeedID: 2},
+		{Title: "no id again", FeedID: 1},
+		{Title: "d", FeedID: 1, GUID: "y", ID: 20},
+	}
+	got := titles(DedupeEpisodesByGUID(episodes))
+	want := []string{"a", "b", "c", "c other feed", "no id", "no id other feed", "no id again", "d"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := DedupeEpisodesByGUID(nil); len(got) != 0 {
+		t.Errorf("nil: got %v", got)
+	}
+}
+
+func TestGroupByPublishDay(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	newYork := time.FixedZone("EST", -5*60*60)
+	// 2024-03-01 20:00 UTC is already March 2nd in Tokyo
+	late := &Episode{Title: "late", DatePublished: Time(time.Date(2024, time.March, 1, 20, 0, 0, 0, time.UTC))}
+	// 2024-03-02 02:00 UTC is still March 1st in New York
+	early := &Episode{Title: "early", DatePublished: Time(time.Date(2024, time.March, 2, 2, 0, 0, 0, time.UTC))}
+	noon := &Episode{Title: "noon", DatePublished: Time(time.Date(2024, time.March, 2, 12, 0, 0, 0, time.UTC))}
+	episodes := []*Episode{late, early, nil, noon}
+	tests := []struct {
+		name string
+		loc  *time.Location
+		want map[string][]string
+	}{
+		{"nil is UTC", nil, map[string][]string{"2024-03-01": {"late"}, "2024-03-02": {"early", "noon"}}},
+		{"UTC", time.UTC, map[string][]string{"2024-03-01": {"late"}, "2024-03-02": {"early", "noon"}}},
+		{"Tokyo", tokyo, map[string][]string{"2024-03-02": {"late", "early", "noon"}}},
+		{"New York", newYork, map[string][]string{"2024-03-01": {"late", "early"}, "2024-03-02": {"noon"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			days := GroupByPublishDay(episodes, test.loc)
+			got := make(map[string][]string, len(days))
+			for day, list := range days {
+				got[day] = titles(list)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPlayableEpisodes(t *testing.T) {
+	episodes := []*Episode{
+		{Title: "mp3", EnclosureURL: "https://example.com/1.mp3", EnclosureType: "audio/mpeg"},
+		{Title: "no url", EnclosureType: "audio/mpeg"},
+		{Title: "blank url", EnclosureURL: "", EnclosureType: ""},
+		nil,
+		{Title: "no type", EnclosureURL: "https://example.com/2.m4a"},
+		{Title: "video", EnclosureURL: "http://example.com/3.mp4", EnclosureType: "Video/MP4; codecs=avc1"},
+		{Title: "hls", EnclosureURL: "https://example.com/live.m3u8", EnclosureType: "application/x-mpegURL"},
+		{Title: "pdf", EnclosureURL: "https://example.com/4.pdf", EnclosureType: "application/pdf"},
+		{Title: "relative", EnclosureURL: "/5.mp3", EnclosureType: "audio/mpeg"},
+		{Title: "ftp", EnclosureURL: "ftp://example.com/6.mp3", EnclosureType: "audio/mpeg"},
+	}
+	got := titles(PlayableEpisodes(episodes))
+	want := []string{"mp3", "no type", "video", "hls"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := PlayableEpisodes(nil); got == nil || len(got) != 0 {
+		t.Errorf("nil: got %#v, want an empty list", got)
+	}
+}
+
+func TestEpisodeShareURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		episode Episode
+		want    string
+	}{
+		{"link wins", Episode{Link: "https://example.com/ep/1", FeedID: 75075, ID: 16795090, EnclosureURL: "https://cdn.example.com/1.mp3"},
+			"https://example.com/ep/1"},
+		{"podcastindex.org", Episode{FeedID: 75075, ID: 16795090, EnclosureURL: "https://cdn.example.com/1.mp3"},
+			"https://podcastindex.org/podcast/75075?episode=16795090"},
+		{"enclosure", Episode{ID: 16795090, EnclosureURL: "https://cdn.example.com/1.mp3"}, "https://cdn.example.com/1.mp3"},
+		{"nothing", Episode{}, ""},
+	}
+	for _, test := range tests {
+		if got := test.episode.ShareURL(); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package podcastindex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidArgument is returned before any request is made when a required
+// argument, like an id, a search term or a feed URL, is empty
+var ErrInvalidArgument = errors.New("Invalid argument")
+
+// ErrNotFound matches the APIError returned when the API answers with status
+// false, because it found nothing for the request, see errors.Is
+var ErrNotFound = errors.New("Not found")
+
+// ErrRateLimited matches the APIError returned for HTTP status 429, when the
+// key sent too many requests. The RetryAfter of the APIError tells how long to
+// wait, see also WithRetries.
+var ErrRateLimited = errors.New("Rate limited")
+
+// ErrPodcastNotFound is returned when the API does not know the podcast that
+// was asked for. It matches ErrNotFound as well.
+var ErrPodcastNotFound = fmt.Errorf("%w: could not find the podcast", ErrNotFound)
+
+// ErrEpisodesTruncated is returned together with the newest MaxEpisodes
+// episodes when a feed has more episodes than the API returns in one call, see
+// AllEpisodesForFeed
+var ErrEpisodesTruncated = fmt.Errorf("Episodes truncated: the API returns at most %d episodes", MaxEpisodes)
+
+// required returns ErrInvalidArgument when value is empty
+func required(name, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("%w: %s is empty", ErrInvalidArgument, name)
+	}
+	return nil
+}
+
+// positive returns ErrInvalidArgument when the id is 0 or negative
+func positive(name string, id int64) error {
+	if id <= 0 {
+		return fmt.Errorf("%w: %s is %d", ErrInvalidArgument, name, id)
+	}
+	return nil
+}
+
+// APIError is returned when the API answers with an HTTP status that is not
+// successful, or with a status field of "false" because it found nothing. Use
+// errors.Is with ErrNotFound to tell the latter apart, errors.As to get the
+// details:
+//
+//	var apiErr *APIError
+//	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests {
+//		time.Sleep(apiErr.RetryAfter)
+//	}
+type APIError struct {
+	// StatusCode is the HTTP status of the response
+	StatusCode int
+	// Status is the status field of the response, "false" when the API found
+	// nothing. It is empty when the body had no status.
+	Status string
+	// Endpoint that was called, without the query
+	Endpoint string
+	// Description the API returned, if any
+	Description string
+	// Err is the error of the method that was called, e.g. ErrPodcastNotFound,
+	// if any
+	Err error
+	// RetryAfter is the time the API asked to wait before the next request, e.g.
+	// for status 429. It is 0 when the API did not send a Retry-After header.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("API returned %d %s for %s", e.StatusCode, http.StatusText(e.StatusCode), e.Endpoint)
+	if e.notFound() {
+		msg = fmt.Sprintf("API returned status false for %s", e.Endpoint)
+	}
+	if e.Description != "" {
+		msg += ": " + e.Description
+	}
+	if e.Err != nil {
+		msg = e.Err.Error() + ": " + msg
+	}
+	return msg
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports if target is ErrNotFound and the API found nothing, or if target
+// is ErrRateLimited and the API answered with status 429
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.notFound()
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+func (e *APIError) notFound() bool {
+	return e.Status == "false" && e.StatusCode >= 200 && e.StatusCode <= 299
+}
+
+// newStatusError returns the error for a successful response with status
+// false, err is the error of the method that was called
+func newStatusError(endpoint, description string, err error) *APIError {
+	return &APIError{
+		StatusCode:  http.StatusOK,
+		Status:      "false",
+		Endpoint:    endpointOf(endpoint),
+		Description: description,
+		Err:         err,
+	}
+}
+
+func newAPIError(res *http.Response, endpoint string, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: res.StatusCode,
+		Endpoint:   endpointOf(endpoint),
+		RetryAfter: parseRetryAfter(res.Header.Get("Retry-After"), time.Now()),
+	}
+	var result struct {
+		Status      interface{} `json:"status"`
+		Description string      `json:"description"`
+	}
+	if json.Unmarshal(body, &result) == nil {
+		if result.Status != nil {
+			e.Status = fmt.Sprint(result.Status)
+		}
+		e.Description = result.Description
+	}
+	return e
+}
+
+// endpointOf strips the query from url
+func endpointOf(url string) string {
+	if i := strings.IndexByte(url, '?'); i >= 0 {
+		return url[:i]
+	}
+	return url
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date. It returns 0 for an empty or invalid value
+// and for dates in the past.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	t, err := http.ParseTime(value)
+	if err != nil || !t.After(now) {
+		return 0
+	}
+	return t.Sub(now)
+}
+
+// NetworkError is returned when the API could not be reached or the response
+// could not be read, e.g. because of DNS failures, refused connections or
+// timeouts. HTTP errors are returned as APIError instead.
+type NetworkError struct {
+	// Endpoint that was called, without the query
+	Endpoint string
+	// Err is the error of the transport
+	Err error
+}
+
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("Could not reach API for %s: %s", e.Endpoint, e.Err)
+}
+
+func (e *NetworkError) Unwrap() error {
+	return e.Err
+}
+
+// Timeout reports if the request timed out
+func (e *NetworkError) Timeout() bool {
+	var t interface{ Timeout() bool }
+	return errors.As(e.Err, &t) && t.Timeout()
+}
+
+// maxErrorBody is the number of bytes of a response body kept in a DecodeError
+const maxErrorBody = 512
+
+// DecodeError is returned when a response of the API could not be decoded. It
+// carries the beginning of the body, to see what the API returned instead.
+type DecodeError struct {
+	// Endpoint that was called, without the query
+	Endpoint string
+	// Body is the response, longer bodies are cut to their first 512 bytes
+	// followed by "..."
+	Body string
+	// Err is the error of the JSON decoder
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("Could not decode response of %s: %s, body: %q", e.Endpoint, e.Err, e.Body)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+func newDecodeError(endpoint string, body []byte, err error) *DecodeError {
+	if len(body) > maxErrorBody {
+		body = append(body[:maxErrorBody:maxErrorBody], "..."...)
+	}
+	return &DecodeError{
+		Endpoint: endpointOf(endpoint),
+		Body:     string(body),
+		Err:      err,
+	}
+}
@@ -0,0 +1,232 @@
+package podcastindex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequired(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{"", false},
+		{" ", false},
+		{"\t\n", false},
+		{"0", true},
+		{"75075", true},
+		{" go ", true},
+	}
+	for _, test := range tests {
+		err := required("id", test.value)
+		if test.valid && err != nil {
+			t.Errorf("required(%q) = %s", test.value, err)
+		}
+		if !test.valid && !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("required(%q) = %v, want ErrInvalidArgument", test.value, err)
+		}
+	}
+}
+
+func TestPositive(t *testing.T) {
+	for _, id := range []int64{0, -1, -75075} {
+		if err := positive("id", id); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("positive(%d) = %v, want ErrInvalidArgument", id, err)
+		}
+	}
+	if err := positive("id", 1); err != nil {
+		t.Errorf("positive(1) = %s", err)
+	}
+}
+
+func TestEmptyArgumentsMakeNoRequest(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		call func(c *Client) error
+	}{
+		{"PodcastByFeedID", func(c *Client) error { _, err := c.PodcastByFeedID(""); return err }},
+		{"PodcastByFeedURL", func(c *Client) error { _, err := c.PodcastByFeedURL(" "); return err }},
+		{"PodcastByITunesID", func(c *Client) error { _, err := c.PodcastByITunesID(""); return err }},
+		{"PodcastByFeedGUID", func(c *Client) error { _, err := c.PodcastByFeedGUID(""); return err }},
+		{"EpisodesByFeedID", func(c *Client) error { _, err := c.EpisodesByFeedID("", 0, time.Time{}); return err }},
+		{"EpisodeByID", func(c *Client) error { _, err := c.EpisodeByID(""); return err }},
+		{"SearchPodcasts", func(c *Client) error { _, err := c.SearchPodcasts(""); return err }},
+		{"SearchEpisodes", func(c *Client) error { _, err := c.SearchEpisodes(""); return err }},
+		{"AddByFeedURL", func(c *Client) error { _, err := c.AddByFeedURLCtx(ctx, ""); return err }},
+		{"AddByITunesID", func(c *Client) error { _, _, err := c.AddByITunesID(0); return err }},
+		{"NotifyFeedUpdated", func(c *Client) error { return c.NotifyFeedUpdated(-1) }},
+		{"ValueByFeedID", func(c *Client) error { _, err := c.ValueByFeedID(0); return err }},
+		{"EpisodesByFeedIDs", func(c *Client) error { _, err := c.EpisodesByFeedIDs([]int64{1, 0}, 0, time.Time{}); return err }},
+		{"EpisodesByFeedIDs in a later chunk", func(c *Client) error {
+			ids := make([]int64, MaxFeedIDs+1)
+			for i := range ids[:MaxFeedIDs] {
+				ids[i] = int64(i + 1)
+			}
+			_, err := c.EpisodesByFeedIDs(ids, 0, time.Time{})
+			return err
+		}},
+		{"RefreshPodcast", func(c *Client) error { _, err := c.RefreshPodcast(ctx, &Podcast{}); return err }},
+		{"AllEpisodesForFeed", func(c *Client) error { _, err := c.AllEpisodesForFeed(ctx, ""); return err }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := 0
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				respond(http.StatusOK, `{"status":"true"}`)(w, r)
+			})
+			if err := test.call(c); !errors.Is(err, ErrInvalidArgument) {
+				t.Errorf("got %v, want ErrInvalidArgument", err)
+			}
+			if requests != 0 {
+				t.Errorf("made %d requests", requests)
+			}
+		})
+	}
+}
+
+func TestAPIErrorNotFound(t *testing.T) {
+	c := newTestClient(t, respond(http.StatusOK, `{"status":"false","description":"No feeds match this id."}`))
+	_, err := c.PodcastByFeedID("1")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("got %v, want ErrNotFound", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Description != "No feeds match this id." || apiErr.Endpoint != "podcasts/byfeedid" {
+		t.Errorf("got %#v", apiErr)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"0", 0},
+		{"5", 5 * time.Second},
+		{" 120 ", 2 * time.Minute},
+		{"-3", 0},
+		{"soon", 0},
+		{"1.5", 0},
+		{now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second},
+		{"Fri, 01 Mar 2024 12:01:00 GMT", time.Minute},
+		{"Friday, 01-Mar-24 12:00:10 GMT", 10 * time.Second},
+		{"Fri Mar  1 12:00:20 2024", 20 * time.Second},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0},
+		{now.Format(http.TimeFormat), 0},
+	}
+	for _, test := range tests {
+		if got := parseRetryAfter(test.value, now); got != test.want {
+			t.Errorf("parseRetryAfter(%q) = %s, want %s", test.value, got, test.want)
+		}
+	}
+}
+
+func TestRateLimitedError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "7")
+		respond(http.StatusTooManyRequests, `{"status":"false","description":"Slow down"}`)(w, r)
+	})
+	_, err := c.PodcastByFeedID("1")
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("got %v, want ErrRateLimited", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RetryAfter != 7*time.Second || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("got %#v", apiErr)
+	}
+}
+
+func TestRetriesAfterRateLimit(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			respond(http.StatusTooManyRequests, `{}`)(w, r)
+			return
+		}
+		respond(http.StatusOK, `{"status":"true","feed":{"id":1}}`)(w, r)
+	}, WithRetries(2))
+	// Retry-After of 0 falls back to defaultRetryAfter, keep the test short
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	p, err := c.PodcastByFeedIDCtx(ctx, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ID != 1 || requests != 2 {
+		t.Errorf("got podcast %d after %d requests", p.ID, requests)
+	}
+}
+
+func TestNetworkErrorAndAPIError(t *testing.T) {
+	// a closed server refuses the connection
+	server := httptest.NewServer(respond(http.StatusOK, `{}`))
+	server.Close()
+	refused := NewClient("key", "secret", WithBaseURL(server.URL))
+	_, err := refused.PodcastByFeedID("1")
+	var netErr *NetworkError
+	if !errors.As(err, &netErr) {
+		t.Fatalf("refused connection: got %T %v, want *NetworkError", err, err)
+	}
+	if netErr.Endpoint != "podcasts/byfeedid" {
+		t.Errorf("Endpoint = %q", netErr.Endpoint)
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		t.Error("refused connection is an APIError as well")
+	}
+
+	c := newTestClient(t, respond(http.StatusBadGateway, `{"description":"upstream down"}`))
+	_, err = c.PodcastByFeedID("1")
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway || apiErr.Description != "upstream down" {
+		t.Fatalf("status 502: got %T %v, want *APIError", err, err)
+	}
+	if errors.As(err, &netErr) {
+		t.Error("status 502 is a NetworkError as well")
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	body := `{"status":"true","feed":{"id":` + strings.Repeat("9", 600)
+	c := newTestClient(t, respond(http.StatusOK, body))
+	_, err := c.PodcastByFeedID("1")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("got %T %v, want *DecodeError", err, err)
+	}
+	if want := body[:maxErrorBody] + "..."; decodeErr.Body != want {
+		t.Errorf("Body has %d bytes, want the first %d and ...", len(decodeErr.Body), maxErrorBody)
+	}
+	var syntaxErr *json.SyntaxError
+	if !errors.As(decodeErr.Unwrap(), &syntaxErr) && !errors.Is(decodeErr.Unwrap(), io.ErrUnexpectedEOF) {
+		t.Errorf("Unwrap = %T %v, want the json error", decodeErr.Unwrap(), decodeErr.Unwrap())
+	}
+	if decodeErr.Endpoint != "podcasts/byfeedid" {
+		t.Errorf("Endpoint = %q", decodeErr.Endpoint)
+	}
+}
+
+func TestDecodeErrorShortBody(t *testing.T) {
+	c := newTestClient(t, respond(http.StatusOK, `<html>Bad Gateway</html>`))
+	_, err := c.StatsCurrent()
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) || decodeErr.Body != `<html>Bad Gateway</html>` {
+		t.Fatalf("got %v", err)
+	}
+	var syntaxErr *json.SyntaxError
+	if !errors.As(decodeErr.Unwrap(), &syntaxErr) {
+		t.Errorf("Unwrap = %T, want *json.SyntaxError", decodeErr.Unwrap())
+	}
+}
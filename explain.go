@@ -0,0 +1,63 @@
+package podcastindex
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+var errExplained = errors.New("request not sent")
+
+// explainer records the URLs of requests instead of sending them, calls like
+// PodcastWithValue make their requests concurrently
+type explainer struct {
+	mu   sync.Mutex
+	urls []string
+}
+
+func (e *explainer) RoundTrip(req *http.Request) (*http.Response, error) {
+	e.mu.Lock()
+	e.urls = append(e.urls, req.URL.String())
+	e.mu.Unlock()
+	return nil, errExplained
+}
+
+// RequestURL returns the URL that call requests from the API, without sending
+// the request. call gets a copy of c that has to be used for the request, e.g.
+//
+//	u, err := c.RequestURL(func(c *Client) error {
+//		_, err := c.EpisodesByFeedID("75075", 10, time.Time{})
+//		return err
+//	})
+//
+// The URL contains all parameters, but not the authentication, which is sent in
+// headers. When call does not make exactly one request an error is returned.
+func (c *Client) RequestURL(call func(c *Client) error) (string, error) {
+	e := &explainer{}
+	dry := *c
+	dry.client = &http.Client{Transport: e}
+	// a dry run must not change the state of c or report a request that was not sent
+	dry.breaker, dry.limiter, dry.keys, dry.logger = nil, nil, nil, nil
+	dry.requestHooks, dry.responseHooks = nil, nil
+	err := call(&dry)
+	switch len(e.urls) {
+	case 0:
+		if err == nil {
+			err = errors.New("No request was made")
+		}
+		return "", err
+	case 1:
+		return e.urls[0], nil
+	}
+	return "", fmt.Errorf("%d requests were made, the URL of a single request is returned", len(e.urls))
+}
+
+// SearchPodcastsURL returns the URL SearchPodcasts requests for term
+func (c *Client) SearchPodcastsURL(term string) string {
+	u, _ := c.RequestURL(func(c *Client) error {
+		_, err := c.SearchPodcasts(term)
+		return err
+	})
+	return u
+}
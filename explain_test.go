@@ -0,0 +1,46 @@
+package podcastindex
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequestURL(t *testing.T) {
+	c := NewClient("key", "secret")
+	u, err := c.RequestURL(func(c *Client) error {
+		_, err := c.EpisodesByFeedID("75075", 10, time.Time{})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := BaseURL + "episodes/byfeedid?fulltext&id=75075&max=10"; u != want {
+		t.Errorf("got %q, want %q", u, want)
+	}
+}
+
+func TestRequestURLNoRequest(t *testing.T) {
+	c := NewClient("key", "secret")
+	if u, err := c.RequestURL(func(c *Client) error { return nil }); u != "" || err == nil {
+		t.Errorf("got %q, %v", u, err)
+	}
+	if u, err := c.RequestURL(func(c *Client) error {
+		_, err := c.PodcastByFeedID("")
+		return err
+	}); u != "" || err == nil {
+		t.Errorf("empty id: got %q, %v", u, err)
+	}
+}
+
+func TestRequestURLSeveralRequests(t *testing.T) {
+	c := NewClient("key", "secret")
+	u, err := c.RequestURL(func(c *Client) error {
+		_, _, err := c.PodcastWithValue(context.Background(), "920666")
+		return err
+	})
+	if u != "" || err == nil || !strings.Contains(err.Error(), "2 requests") {
+		t.Errorf("got %q, %v", u, err)
+	}
+}
@@ -0,0 +1,163 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// defaultConcurrency is used by the batch methods when concurrency is not set
+const defaultConcurrency = 4
+
+// fanOut calls fn for every index from 0 to n-1, with at most concurrency calls
+// running at the same time. All errors are joined. Once ctx is done no more
+// calls are started and the error of ctx is part of the result.
+func fanOut(ctx context.Context, n, concurrency int, fn func(i int) error) error {
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return errors.Join(append(errs, ctx.Err())...)
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(i); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// FeedsWithNewEpisodesSince checks for every feed id if the podcast has an
+// episode published after since, with at most concurrency requests at the same
+// time. Feeds that could not be checked are missing in the result and their
+// errors are joined into the returned error, so partial results can be used.
+func (c *Client) FeedsWithNewEpisodesSince(ctx context.Context, feedIDs []string, since time.Time, concurrency int) (map[string]bool, error) {
+	var mu sync.Mutex
+	result := make(map[string]bool, len(feedIDs))
+	err := fanOut(ctx, len(feedIDs), concurrency, func(i int) error {
+		id := feedIDs[i]
+		if err := required("feedID", id); err != nil {
+			return err
+		}
+		url := c.newURL("episodes/byfeedid").set("id", id).max(1).since(since).String()
+		episodes, err := c.getEpisodes(ctx, url, errors.New("Could not get episodes by feed id"))
+		if err != nil {
+			return fmt.Errorf("feed %s: %w", id, err)
+		}
+		mu.Lock()
+		result[id] = len(episodes) > 0
+		mu.Unlock()
+		return nil
+	})
+	return result, err
+}
+
+// BacklogDuration returns the total duration and the number of the episodes
+// published after since across the given feeds, e.g. to show how much there is
+// to catch up on. Up to MaxEpisodes episodes are counted per feed. Feeds that
+// could not be fetched are not counted and their errors are joined into the
+// returned error.
+func (c *Client) BacklogDuration(ctx context.Context, feedIDs []string, since time.Time) (time.Duration, int, error) {
+	var (
+		mu    sync.Mutex
+		total time.Duration
+		count int
+	)
+	err := fanOut(ctx, len(feedIDs), defaultConcurrency, func(i int) error {
+		id := feedIDs[i]
+		if err := required("feedID", id); err != nil {
+			return err
+		}
+		url := c.newURL("episodes/byfeedid").set("id", id).max(MaxEpisodes).since(since).String()
+		episodes, err := c.getEpisodes(ctx, url, errors.New("Could not get episodes by feed id"))
+		if err != nil {
+			return fmt.Errorf("feed %s: %w", id, err)
+		}
+		mu.Lock()
+		total += TotalDuration(episodes)
+		count += len(episodes)
+		mu.Unlock()
+		return nil
+	})
+	return total, count, err
+}
+
+// NewestInCategories returns the recently updated podcasts of each category,
+// with up to perCategory podcasts each, keyed by category name. The categories
+// are checked against CachedCategories and requested concurrently. Unknown
+// categories and failed requests are missing in the result, their errors are
+// joined into the returned error.
+func (c *Client) NewestInCategories(ctx context.Context, categories []string, perCategory int) (map[string][]*RecentPodcast, error) {
+	if _, err := c.CachedCategories(ctx); err != nil {
+		return nil, err
+	}
+	var mu sync.Mutex
+	result := make(map[string][]*RecentPodcast, len(categories))
+	err := fanOut(ctx, len(categories), defaultConcurrency, func(i int) error {
+		name := categories[i]
+		if _, ok := c.CategoryIDCtx(ctx, name); !ok {
+			return fmt.Errorf("%w: unknown category %q", ErrInvalidArgument, name)
+		}
+		feeds, err := c.recentPodcasts(ctx, nil, []string{name}, nil, perCategory, time.Time{})
+		if err != nil {
+			return fmt.Errorf("category %s: %w", name, err)
+		}
+		mu.Lock()
+		result[name] = feeds
+		mu.Unlock()
+		return nil
+	})
+	return result, err
+}
+
+// ValuesByFeedIDs returns the value blocks of the given podcasts keyed by feed
+// id, e.g. for the splits of all subscriptions. The API has no batch endpoint,
+// so the value blocks are requested concurrently, at most 4 at the same time to
+// not run into the rate limit. Podcasts without a value block are missing in
+// the result, as are the ones that failed; their errors are joined into the
+// returned error.
+func (c *Client) ValuesByFeedIDs(ids []int64) (map[int64]*Value, error) {
+	return c.ValuesByFeedIDsCtx(context.Background(), ids)
+}
+
+// ValuesByFeedIDsCtx works like ValuesByFeedIDs, it is canceled when ctx is
+// done
+func (c *Client) ValuesByFeedIDsCtx(ctx context.Context, ids []int64) (map[int64]*Value, error) {
+	var mu sync.Mutex
+	result := make(map[int64]*Value, len(ids))
+	err := fanOut(ctx, len(ids), defaultConcurrency, func(i int) error {
+		id := ids[i]
+		value, err := c.ValueByFeedIDCtx(ctx, id)
+		if err != nil {
+			return fmt.Errorf("feed %d: %w", id, err)
+		}
+		if value != nil {
+			mu.Lock()
+			result[id] = value
+			mu.Unlock()
+		}
+		return nil
+	})
+	return result, err
+}
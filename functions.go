@@ -1,14 +1,25 @@
 package podcastindex
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
 	"time"
 )
 
 // SearchPodcasts for podcasts, authors or owners
 func (c *Client) SearchPodcasts(term string) ([]*Podcast, error) {
-	return c.SearchPodcastsC(term, false, 0)
+	return c.SearchPodcastsCtx(context.Background(), term)
+}
+
+// SearchPodcastsCtx works like SearchPodcasts, it is canceled when ctx is done
+func (c *Client) SearchPodcastsCtx(ctx context.Context, term string) ([]*Podcast, error) {
+	return c.SearchPodcastsWithOptionsCtx(ctx, term, SearchOptions{})
 }
 
 // SearchPodcastsC for searching with more options than Search
@@ -18,31 +29,32 @@ func (c *Client) SearchPodcasts(term string) ([]*Podcast, error) {
 // - fullBody to return the more then 100 characters in the descriptions
 //
 // - max for the number of results, when set to 0 it uses the API default
+//
+// Deprecated: Use SearchPodcastsWithOptions, which takes all options of the
+// search and can get new ones without changing its signature.
 func (c *Client) SearchPodcastsC(term string, clean bool, max int) ([]*Podcast, error) {
-	url := fmt.Sprintf("search/byterm?q=\"%s\"&fulltext%s%s", term, addClean(clean), addMax(max))
-	result := &PodcastArrayResult{}
-	err := c.request(url, result)
-	if err != nil {
-		return nil, err
-	}
-	if result.Status == "false" {
-		return nil, errors.New("Could not find a podcast for that term")
-	}
-	return result.Feeds, err
+	return c.SearchPodcastsWithOptions(term, SearchOptions{Clean: clean, Max: max})
+}
+
+// SearchPodcastsWithMeta works like SearchPodcastsC, but returns the complete
+// result including the count and the description of the API
+func (c *Client) SearchPodcastsWithMeta(term string, clean bool, max int) (*PodcastArrayResult, error) {
+	return c.SearchPodcastsWithMetaCtx(context.Background(), term, clean, max)
+}
+
+// SearchPodcastsWithMetaCtx works like SearchPodcastsWithMeta, it is canceled
+// when ctx is done
+func (c *Client) SearchPodcastsWithMetaCtx(ctx context.Context, term string, clean bool, max int) (*PodcastArrayResult, error) {
+	return c.searchPodcasts(ctx, "search/byterm", term, SearchOptions{Clean: clean, Max: max})
 }
 
 
 func (c *Client) getPodcastsByRss(rssurl string) (*Podcast, error) {
-	url := fmt.Sprintf("podcasts/byfeedurl?url=\"%s\"&fulltext", rssurl)
-	result := &PodcastResult{}
-	err := c.request(url, result)
-	if err != nil {
+	if err := required("url", rssurl); err != nil {
 		return nil, err
 	}
-	if result.Status == "false" {
-		return nil, errors.New("Could not find a podcast for that term")
-	}
-	return &result.Feed, err
+	url := c.newURL("podcasts/byfeedurl").set("url", rssurl).fullText().String()
+	return c.getPodcast(context.Background(), url, errors.New("Could not find a podcast for that term"))
 }
 
 /*This call returns all of the episodes where the specified person is mentioned.
@@ -54,55 +66,204 @@ It searches the following fields:
 - Episode description
 - Feed owner
 - Feed author
+
+See SearchEpisodesWithOptions for all options
 */
 func (c *Client) SearchEpisodes(term string) ([]*Episode, error) {
-	url := fmt.Sprintf("search/byperson?q=\"%s\"&fulltext", term)	
-	return c.getEpisodes(url, errors.New("Could not find a episode for that term"))
+	return c.SearchEpisodesCtx(context.Background(), term)
+}
+
+// SearchEpisodesCtx works like SearchEpisodes, it is canceled when ctx is done
+func (c *Client) SearchEpisodesCtx(ctx context.Context, term string) ([]*Episode, error) {
+	return c.SearchEpisodesWithOptionsCtx(ctx, EpisodeSearchOptions{Term: term, FullText: true})
+}
+
+// SearchEpisodesWithMeta works like SearchEpisodes, but returns the complete
+// result including the count and the description of the API
+func (c *Client) SearchEpisodesWithMeta(term string) (*EpisodeArrayResponse, error) {
+	return c.SearchEpisodesWithMetaCtx(context.Background(), term)
+}
+
+// SearchEpisodesWithMetaCtx works like SearchEpisodesWithMeta, it is canceled
+// when ctx is done
+func (c *Client) SearchEpisodesWithMetaCtx(ctx context.Context, term string) (*EpisodeArrayResponse, error) {
+	return c.searchEpisodes(ctx, EpisodeSearchOptions{Term: term, FullText: true})
 }
 
 // internal function
-func (c *Client) getPodcast(url string, notFound error) (*Podcast, error) {
-	result := &PodcastResult{}
-	err := c.request(url, result)
+func (c *Client) getPodcast(ctx context.Context, url string, notFound error) (*Podcast, error) {
+	result, err := c.getPodcastWithMeta(ctx, url, notFound)
 	if err != nil {
 		return nil, err
 	}
-	if result.Status == "false" {
-		return nil, notFound
+	return &result.Feed, nil
+}
+
+func (c *Client) getPodcastWithMeta(ctx context.Context, url string, notFound error) (*PodcastResult, error) {
+	raw := &rawPodcastResult{}
+	err := c.requestContext(ctx, url, raw)
+	if err != nil {
+		return nil, err
+	}
+	if raw.Status == "false" || raw.noFeed() {
+		return nil, newStatusError(url, raw.Description, notFound)
+	}
+	result := &PodcastResult{Status: raw.Status, Description: raw.Description}
+	result.Query.URL = raw.Query.URL
+	if err := c.decodeBody(raw.Feed, &result.Feed); err != nil {
+		return nil, newDecodeError(url, raw.Feed, err)
 	}
-	return &result.Feed, err
+	return result, nil
+}
+
+// rawPodcastResult is a PodcastResult with the feed left undecoded, because
+// the API sends an empty list instead of an object when it knows no podcast
+type rawPodcastResult struct {
+	Status string `json:"status"`
+	Query  struct {
+		URL string `json:"url"`
+	} `json:"query"`
+	Feed        json.RawMessage `json:"feed"`
+	Description string          `json:"description"`
+}
+
+// noFeed reports if the feed is missing, null or an empty list
+func (r *rawPodcastResult) noFeed() bool {
+	feed := bytes.TrimSpace(r.Feed)
+	return len(feed) == 0 || bytes.Equal(feed, []byte("null")) ||
+		bytes.Equal(bytes.Join(bytes.Fields(feed), nil), []byte("[]"))
 }
 
 // PodcastByFeedURL returns general information about a podcast by its
 // feed URL
 func (c *Client) PodcastByFeedURL(url string) (*Podcast, error) {
-	u := fmt.Sprintf("podcasts/byfeedurl?url=%s&fulltext", url)
-	return c.getPodcast(u, errors.New("Could not find a podcast for that feed URL"))
+	return c.PodcastByFeedURLCtx(context.Background(), url)
+}
+
+// PodcastByFeedURLCtx works like PodcastByFeedURL, it is canceled when ctx is
+// done
+func (c *Client) PodcastByFeedURLCtx(ctx context.Context, url string) (*Podcast, error) {
+	if err := required("url", url); err != nil {
+		return nil, err
+	}
+	u := c.newURL("podcasts/byfeedurl").set("url", url).fullText().String()
+	return c.getPodcast(ctx, u, errors.New("Could not find a podcast for that feed URL"))
+}
+
+// IsFeedIndexed reports if the podcast with the given feed URL is in the index.
+// A podcast which can not be found is not an error, errors are only returned
+// when the API could not be asked.
+func (c *Client) IsFeedIndexed(ctx context.Context, feedURL string) (bool, error) {
+	if err := required("feedURL", feedURL); err != nil {
+		return false, err
+	}
+	url := c.newURL("podcasts/byfeedurl").set("url", feedURL).String()
+	p, err := c.getPodcast(ctx, url, errors.New("Could not find a podcast for that feed URL"))
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return p.ID != 0, nil
 }
 
 // PodcastByFeedID returns general information about a podcast by its id
 func (c *Client) PodcastByFeedID(id string) (*Podcast, error) {
-	url := fmt.Sprintf("podcasts/byfeedid?id=%s&fulltext", id)
-	return c.getPodcast(url, errors.New("Could not find a podcast for that id"))
+	return c.PodcastByFeedIDCtx(context.Background(), id)
+}
+
+// PodcastByFeedIDCtx works like PodcastByFeedID, it is canceled when ctx is
+// done
+func (c *Client) PodcastByFeedIDCtx(ctx context.Context, id string) (*Podcast, error) {
+	return c.podcastByFeedID(ctx, id)
+}
+
+func (c *Client) podcastByFeedID(ctx context.Context, id string) (*Podcast, error) {
+	if err := required("id", id); err != nil {
+		return nil, err
+	}
+	url := c.newURL("podcasts/byfeedid").set("id", id).fullText().String()
+	return c.getPodcast(ctx, url, errors.New("Could not find a podcast for that id"))
+}
+
+// PodcastByFeedIDWithMeta works like PodcastByFeedID, but returns the complete
+// result including the description of the API
+func (c *Client) PodcastByFeedIDWithMeta(id string) (*PodcastResult, error) {
+	return c.PodcastByFeedIDWithMetaCtx(context.Background(), id)
+}
+
+// PodcastByFeedIDWithMetaCtx works like PodcastByFeedIDWithMeta, it is canceled
+// when ctx is done
+func (c *Client) PodcastByFeedIDWithMetaCtx(ctx context.Context, id string) (*PodcastResult, error) {
+	if err := required("id", id); err != nil {
+		return nil, err
+	}
+	url := c.newURL("podcasts/byfeedid").set("id", id).fullText().String()
+	return c.getPodcastWithMeta(ctx, url, errors.New("Could not find a podcast for that id"))
 }
 
 // PodcastByITunesID returns general information about a podcast by its
 // ITune id
 func (c *Client) PodcastByITunesID(id string) (*Podcast, error) {
-	url := fmt.Sprintf("podcasts/byitunesid?id=%s&fulltext", id)
-	return c.getPodcast(url, errors.New("Could not find a podcast for that iTunes id"))
+	return c.PodcastByITunesIDCtx(context.Background(), id)
+}
+
+// PodcastByITunesIDCtx works like PodcastByITunesID, it is canceled when ctx is
+// done
+func (c *Client) PodcastByITunesIDCtx(ctx context.Context, id string) (*Podcast, error) {
+	return c.podcastByITunesID(ctx, id)
 }
 
-func (c *Client) getEpisodes(url string, notFound error) ([]*Episode, error) {
+func (c *Client) podcastByITunesID(ctx context.Context, id string) (*Podcast, error) {
+	if err := required("id", id); err != nil {
+		return nil, err
+	}
+	url := c.newURL("podcasts/byitunesid").set("id", id).fullText().String()
+	return c.getPodcast(ctx, url, errors.New("Could not find a podcast for that iTunes id"))
+}
+
+// PodcastByFeedGUID returns general information about a podcast by its
+// podcast:guid
+func (c *Client) PodcastByFeedGUID(guid string) (*Podcast, error) {
+	return c.PodcastByFeedGUIDCtx(context.Background(), guid)
+}
+
+// PodcastByFeedGUIDCtx works like PodcastByFeedGUID, it is canceled when ctx is
+// done
+func (c *Client) PodcastByFeedGUIDCtx(ctx context.Context, guid string) (*Podcast, error) {
+	return c.podcastByFeedGUID(ctx, guid)
+}
+
+func (c *Client) podcastByFeedGUID(ctx context.Context, guid string) (*Podcast, error) {
+	if err := required("guid", guid); err != nil {
+		return nil, err
+	}
+	url := c.newURL("podcasts/byguid").set("guid", guid).fullText().String()
+	return c.getPodcast(ctx, url, ErrPodcastNotFound)
+}
+
+func (c *Client) getEpisodes(ctx context.Context, url string, notFound error) ([]*Episode, error) {
+	result, err := c.getEpisodesWithMeta(ctx, url, notFound)
+	if err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
+
+func (c *Client) getEpisodesWithMeta(ctx context.Context, url string, notFound error) (*EpisodeArrayResponse, error) {
 	result := &EpisodeArrayResponse{}
-	err := c.request(url, result)
+	err := c.requestContext(ctx, url, result)
 	if err != nil {
 		return nil, err
 	}
 	if result.Status == "false" {
-		return nil, notFound
+		if err := c.listNotFound(url, result.Description, notFound); err != nil {
+			return nil, err
+		}
+		result.Items = []*Episode{}
 	}
-	return result.Items, nil
+	return result, nil
 }
 
 // EpisodesByFeedID returns all episodes for a podcast by its id
@@ -113,8 +274,198 @@ func (c *Client) getEpisodes(url string, notFound error) ([]*Episode, error) {
 // - since = only return episodes since that time. Set time to zero to not filter
 // by time
 func (c *Client) EpisodesByFeedID(id string, max int, since time.Time) ([]*Episode, error) {
-	url := fmt.Sprintf("episodes/byfeedid?id=%s&fulltext%s%s", id, addMax(max), addTime(since))
-	return c.getEpisodes(url, errors.New("Could not get episodes by feed id"))
+	return c.EpisodesByFeedIDCtx(context.Background(), id, max, since)
+}
+
+// EpisodesByFeedIDCtx works like EpisodesByFeedID, it is canceled when ctx is
+// done
+func (c *Client) EpisodesByFeedIDCtx(ctx context.Context, id string, max int, since time.Time) ([]*Episode, error) {
+	return c.EpisodesByFeedIDWithOptionsCtx(ctx, id, EpisodeOptions{Max: max, Since: since})
+}
+
+// EpisodeOptions are the optional parameters of the methods that list the
+// episodes of a podcast, the zero value uses the API defaults
+type EpisodeOptions struct {
+	// Max is the number of results, 0 uses the API default
+	Max int
+	// Since only returns episodes since that time, the zero time does not filter
+	Since time.Time
+}
+
+// EpisodesByFeedIDWithOptions returns the episodes of a podcast by its id like
+// EpisodesByFeedID, with the given options
+func (c *Client) EpisodesByFeedIDWithOptions(id string, opts EpisodeOptions) ([]*Episode, error) {
+	return c.EpisodesByFeedIDWithOptionsCtx(context.Background(), id, opts)
+}
+
+// EpisodesByFeedIDWithOptionsCtx works like EpisodesByFeedIDWithOptions, it is
+// canceled when ctx is done
+func (c *Client) EpisodesByFeedIDWithOptionsCtx(ctx context.Context, id string, opts EpisodeOptions) ([]*Episode, error) {
+	if err := required("id", id); err != nil {
+		return nil, err
+	}
+	url := c.newURL("episodes/byfeedid").set("id", id).fullText().max(opts.Max).since(opts.Since).String()
+	return c.getEpisodes(ctx, url, errors.New("Could not get episodes by feed id"))
+}
+
+// EpisodesByFeedIDWithMeta works like EpisodesByFeedID, but returns the complete
+// result including the count and the description of the API
+func (c *Client) EpisodesByFeedIDWithMeta(id string, max int, since time.Time) (*EpisodeArrayResponse, error) {
+	return c.EpisodesByFeedIDWithMetaCtx(context.Background(), id, max, since)
+}
+
+// EpisodesByFeedIDWithMetaCtx works like EpisodesByFeedIDWithMeta, it is
+// canceled when ctx is done
+func (c *Client) EpisodesByFeedIDWithMetaCtx(ctx context.Context, id string, max int, since time.Time) (*EpisodeArrayResponse, error) {
+	if err := required("id", id); err != nil {
+		return nil, err
+	}
+	url := c.newURL("episodes/byfeedid").set("id", id).fullText().max(max).since(since).String()
+	return c.getEpisodesWithMeta(ctx, url, errors.New("Could not get episodes by feed id"))
+}
+
+// MaxEpisodes is the maximum number of episodes the API returns per call
+const MaxEpisodes = 1000
+
+// MaxFeedIDs is the maximum number of feed ids the API accepts per call of
+// EpisodesByFeedIDs
+const MaxFeedIDs = 200
+
+// EpisodesByFeedIDs returns the episodes of several podcasts with one request,
+// e.g. to refresh all subscriptions, newest first. More than MaxFeedIDs ids are
+// split into one request per MaxFeedIDs ids.
+//
+// - max = number of episodes to return per request, not per podcast. If max is 0
+// the default number of episodes will be returned
+//
+// - since = only return episodes since that time. Set time to zero to not filter
+// by time
+func (c *Client) EpisodesByFeedIDs(ids []int64, max int, since time.Time) ([]*Episode, error) {
+	return c.EpisodesByFeedIDsCtx(context.Background(), ids, max, since)
+}
+
+// EpisodesByFeedIDsCtx works like EpisodesByFeedIDs, it is canceled when ctx is
+// done
+func (c *Client) EpisodesByFeedIDsCtx(ctx context.Context, ids []int64, max int, since time.Time) ([]*Episode, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: ids is empty", ErrInvalidArgument)
+	}
+	for _, id := range ids {
+		if err := positive("id", id); err != nil {
+			return nil, err
+		}
+	}
+	var all []*Episode
+	for start := 0; start < len(ids); start += MaxFeedIDs {
+		end := start + MaxFeedIDs
+		if end > len(ids) {
+			end = len(ids)
+		}
+		list := make([]string, 0, end-start)
+		for _, id := range ids[start:end] {
+			list = append(list, strconv.FormatInt(id, 10))
+		}
+		url := c.newURL("episodes/byfeedid").list("id", list).fullText().max(max).since(since).String()
+		episodes, err := c.getEpisodes(ctx, url, errors.New("Could not get episodes by feed ids"))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, episodes...)
+	}
+	if len(ids) > MaxFeedIDs {
+		sort.SliceStable(all, func(i, j int) bool {
+			return time.Time(all[i].DatePublished).After(time.Time(all[j].DatePublished))
+		})
+	}
+	return all, nil
+}
+
+// EpisodesInRange returns the episodes of a podcast published between from and
+// to, both inclusive. Only the newest MaxEpisodes episodes published since from
+// are considered, because the API can not be asked for episodes before a time.
+// A zero from does not limit the start.
+func (c *Client) EpisodesInRange(ctx context.Context, feedID string, from, to time.Time) ([]*Episode, error) {
+	if err := required("feedID", feedID); err != nil {
+		return nil, err
+	}
+	// since is exclusive, so it starts a second early to include from
+	since := from
+	if !since.IsZero() {
+		since = since.Add(-time.Second)
+	}
+	url := c.newURL("episodes/byfeedid").set("id", feedID).fullText().
+		max(MaxEpisodes).since(since).String()
+	episodes, err := c.getEpisodes(ctx, url, errors.New("Could not get episodes by feed id"))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Episode, 0, len(episodes))
+	for _, e := range episodes {
+		published := time.Time(e.DatePublished)
+		if !published.Before(from) && !published.After(to) {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+// EpisodesByNumberRange returns the episodes of a podcast with an episode number
+// from fromNum to toNum, both inclusive, sorted by season and number, e.g. for
+// serial shows and audiobooks. A season of 0 matches all seasons, otherwise only
+// episodes of that season are returned. Episodes without a number are left out
+// and missing numbers are skipped, so the result can have gaps. Only the newest
+// MaxEpisodes episodes are considered.
+func (c *Client) EpisodesByNumberRange(ctx context.Context, feedID string, season, fromNum, toNum int) ([]*Episode, error) {
+	if err := required("feedID", feedID); err != nil {
+		return nil, err
+	}
+	if fromNum > toNum {
+		return nil, fmt.Errorf("%w: fromNum %d is after toNum %d", ErrInvalidArgument, fromNum, toNum)
+	}
+	url := c.newURL("episodes/byfeedid").set("id", feedID).fullText().max(MaxEpisodes).String()
+	episodes, err := c.getEpisodes(ctx, url, errors.New("Could not get episodes by feed id"))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Episode, 0, len(episodes))
+	for _, e := range episodes {
+		if e == nil || e.Episode == 0 || (season != 0 && e.Season != season) {
+			continue
+		}
+		if e.Episode >= fromNum && e.Episode <= toNum {
+			result = append(result, e)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Season != result[j].Season {
+			return result[i].Season < result[j].Season
+		}
+		return result[i].Episode < result[j].Episode
+	})
+	return result, nil
+}
+
+// AllEpisodesForFeed returns the episodes of a podcast, newest first. The API
+// returns at most MaxEpisodes episodes per call and can not be asked for
+// episodes before a time or from an offset, so older episodes can not be
+// requested. When the feed has more episodes than that, the newest MaxEpisodes
+// episodes are returned together with ErrEpisodesTruncated.
+func (c *Client) AllEpisodesForFeed(ctx context.Context, feedID string) ([]*Episode, error) {
+	if err := required("feedID", feedID); err != nil {
+		return nil, err
+	}
+	url := c.newURL("episodes/byfeedid").set("id", feedID).fullText().max(MaxEpisodes).String()
+	episodes, err := c.getEpisodes(ctx, url, errors.New("Could not get episodes by feed id"))
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(episodes, func(i, j int) bool {
+		return time.Time(episodes[i].DatePublished).After(time.Time(episodes[j].DatePublished))
+	})
+	if len(episodes) >= MaxEpisodes {
+		return episodes, ErrEpisodesTruncated
+	}
+	return episodes, nil
 }
 
 // EpisodesByFeedURL returns episodes for a podcast by its feed URL
@@ -125,8 +476,29 @@ func (c *Client) EpisodesByFeedID(id string, max int, since time.Time) ([]*Episo
 // - since = only return episodes since that time. Set time to zero to not filter
 // by time
 func (c *Client) EpisodesByFeedURL(feedURL string, max int, since time.Time) ([]*Episode, error) {
-	url := fmt.Sprintf("episodes/byfeedurl?url=\"%s\"&fulltext%s%s", feedURL, addMax(max), addTime(since))
-	return c.getEpisodes(url, errors.New("Could not get episodes by feed URL"))
+	return c.EpisodesByFeedURLCtx(context.Background(), feedURL, max, since)
+}
+
+// EpisodesByFeedURLCtx works like EpisodesByFeedURL, it is canceled when ctx is
+// done
+func (c *Client) EpisodesByFeedURLCtx(ctx context.Context, feedURL string, max int, since time.Time) ([]*Episode, error) {
+	return c.EpisodesByFeedURLWithOptionsCtx(ctx, feedURL, EpisodeOptions{Max: max, Since: since})
+}
+
+// EpisodesByFeedURLWithOptions returns the episodes of a podcast by its feed
+// URL like EpisodesByFeedURL, with the given options
+func (c *Client) EpisodesByFeedURLWithOptions(feedURL string, opts EpisodeOptions) ([]*Episode, error) {
+	return c.EpisodesByFeedURLWithOptionsCtx(context.Background(), feedURL, opts)
+}
+
+// EpisodesByFeedURLWithOptionsCtx works like EpisodesByFeedURLWithOptions, it
+// is canceled when ctx is done
+func (c *Client) EpisodesByFeedURLWithOptionsCtx(ctx context.Context, feedURL string, opts EpisodeOptions) ([]*Episode, error) {
+	if err := required("feedURL", feedURL); err != nil {
+		return nil, err
+	}
+	url := c.newURL("episodes/byfeedurl").set("url", feedURL).fullText().max(opts.Max).since(opts.Since).String()
+	return c.getEpisodes(ctx, url, errors.New("Could not get episodes by feed URL"))
 }
 
 // EpisodesByITunesID returns episodes for a podcast by its iTunes id
@@ -137,20 +509,53 @@ func (c *Client) EpisodesByFeedURL(feedURL string, max int, since time.Time) ([]
 // - since = only return episodes since that time. Set time to zero to not filter
 // by time
 func (c *Client) EpisodesByITunesID(id string, max int, since time.Time) ([]*Episode, error) {
-	url := fmt.Sprintf("episodes/byitunesid?id=%s&fulltext%s%s", id, addMax(max), addTime(since))
-	return c.getEpisodes(url, errors.New("Could not get episodes by iTunes id"))
+	return c.EpisodesByITunesIDCtx(context.Background(), id, max, since)
+}
+
+// EpisodesByITunesIDCtx works like EpisodesByITunesID, it is canceled when ctx
+// is done
+func (c *Client) EpisodesByITunesIDCtx(ctx context.Context, id string, max int, since time.Time) ([]*Episode, error) {
+	return c.EpisodesByITunesIDWithOptionsCtx(ctx, id, EpisodeOptions{Max: max, Since: since})
+}
+
+// EpisodesByITunesIDWithOptions returns the episodes of a podcast by its
+// iTunes id like EpisodesByITunesID, with the given options
+func (c *Client) EpisodesByITunesIDWithOptions(id string, opts EpisodeOptions) ([]*Episode, error) {
+	return c.EpisodesByITunesIDWithOptionsCtx(context.Background(), id, opts)
+}
+
+// EpisodesByITunesIDWithOptionsCtx works like EpisodesByITunesIDWithOptions,
+// it is canceled when ctx is done
+func (c *Client) EpisodesByITunesIDWithOptionsCtx(ctx context.Context, id string, opts EpisodeOptions) ([]*Episode, error) {
+	if err := required("id", id); err != nil {
+		return nil, err
+	}
+	url := c.newURL("episodes/byitunesid").set("id", id).fullText().max(opts.Max).since(opts.Since).String()
+	return c.getEpisodes(ctx, url, errors.New("Could not get episodes by iTunes id"))
 }
 
 // EpisodeByID return a single episode by its id
 func (c *Client) EpisodeByID(id string) (*Episode, error) {
-	url := fmt.Sprintf("episodes/byid?id=%s&fulltext", id)
+	return c.EpisodeByIDCtx(context.Background(), id)
+}
+
+// EpisodeByIDCtx works like EpisodeByID, it is canceled when ctx is done
+func (c *Client) EpisodeByIDCtx(ctx context.Context, id string) (*Episode, error) {
+	return c.episodeByID(ctx, id)
+}
+
+func (c *Client) episodeByID(ctx context.Context, id string) (*Episode, error) {
+	if err := required("id", id); err != nil {
+		return nil, err
+	}
+	url := c.newURL("episodes/byid").set("id", id).fullText().String()
 	result := &EpisodeResponse{}
-	err := c.request(url, result)
+	err := c.requestContext(ctx, url, result)
 	if err != nil {
 		return nil, err
 	}
 	if result.Status == "false" {
-		return nil, errors.New("Could not find episode")
+		return nil, newStatusError(url, result.Description, errors.New("Could not find episode"))
 	}
 	return result.Episode, nil
 }
@@ -171,18 +576,78 @@ func (c *Client) EpisodeByID(id string) (*Episode, error) {
 // - max = number of episodes to return, if max is 0 the default number of episodes will be
 // returned, the default is 1
 func (c *Client) RandomEpisodes(languages, categories, notCategories []string, max int) ([]*Episode, error) {
-	url := fmt.Sprintf("episodes/random?fulltext%s%s%s%s", addMax(max), addFilter("lang", languages), addFilter("cat", categories), addFilter("notcat", notCategories))
+	return c.RandomEpisodesCtx(context.Background(), languages, categories, notCategories, max)
+}
+
+// RandomEpisodesCtx works like RandomEpisodes, it is canceled when ctx is done
+func (c *Client) RandomEpisodesCtx(ctx context.Context, languages, categories, notCategories []string, max int) ([]*Episode, error) {
+	url := c.newURL("episodes/random").fullText().max(max).
+		list("lang", languages).list("cat", categories).list("notcat", notCategories).String()
 	result := &RandomEpisodesResponse{}
-	err := c.request(url, result)
+	err := c.requestContext(ctx, url, result)
 	if err != nil {
 		return nil, err
 	}
 	if result.Status == "false" {
-		return nil, errors.New("Could not get random episodes")
+		if err := c.listNotFound(url, result.Description, errors.New("Could not get random episodes")); err != nil {
+			return nil, err
+		}
+		result.Items = []*Episode{}
 	}
 	return result.Items, nil
 }
 
+// randomPodcastsPool is the number of trending podcasts RandomPodcasts picks from
+const randomPodcastsPool = 200
+
+// RandomPodcasts returns podcasts picked at random. The API has no random
+// endpoint for podcasts, so this is an approximation: it picks from the top
+// trending podcasts matching the filters, which are less random than
+// RandomEpisodes and favor popular podcasts.
+//
+// - languages, categories and notCategories filter like in PodcastsTrending
+//
+// - medium = only return podcasts of this medium, feeds without a medium are
+// podcasts, see MediumOrDefault. Leave empty if the medium does not matter
+//
+// - max = number of podcasts to return, if max is 0 the default number of podcasts will be
+// returned, the default is 1
+func (c *Client) RandomPodcasts(languages, categories, notCategories []string, medium Medium, max int) ([]*Podcast, error) {
+	return c.RandomPodcastsCtx(context.Background(), languages, categories, notCategories, medium, max)
+}
+
+// RandomPodcastsCtx works like RandomPodcasts, it is canceled when ctx is done
+func (c *Client) RandomPodcastsCtx(ctx context.Context, languages, categories, notCategories []string, medium Medium, max int) ([]*Podcast, error) {
+	if max == 0 {
+		max = c.defaultMax
+	}
+	if max == 0 {
+		max = 1
+	}
+	feeds, err := c.PodcastsTrendingCtx(ctx, languages, categories, notCategories, randomPodcastsPool, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	pool := make([]*Podcast, 0, len(feeds))
+	for _, p := range feeds {
+		if p != nil && (medium == "" || p.MediumOrDefault() == medium) {
+			pool = append(pool, p)
+		}
+	}
+	rand.Shuffle(len(pool), func(i, j int) {
+		pool[i], pool[j] = pool[j], pool[i]
+	})
+	if len(pool) > max {
+		pool = pool[:max]
+	}
+	if len(pool) == 0 {
+		if err := c.listNotFound("podcasts/trending", "", errors.New("Could not get random podcasts")); err != nil {
+			return nil, err
+		}
+	}
+	return pool, nil
+}
+
 // RecentEpisodes returns the last episodes across the entire database
 //
 // - before = only return episodes that are older than the episode with this id. set to zero
@@ -193,9 +658,44 @@ func (c *Client) RandomEpisodes(languages, categories, notCategories []string, m
 //
 // - max = number of episodes to return, if max is 0 the default number of episodes will be
 // returned, the default is 10
+//
+// See RecentEpisodesWithOptions for all options
 func (c *Client) RecentEpisodes(before int, max int, exclude string) ([]*Episode, error) {
-	url := fmt.Sprintf("recent/episodes?fulltext%s%s%s", addMax(max), addExclude(exclude), addBefore(before))
-	return c.getEpisodes(url, errors.New("Could not get recent episodes"))
+	return c.RecentEpisodesCtx(context.Background(), before, max, exclude)
+}
+
+// RecentEpisodesCtx works like RecentEpisodes, it is canceled when ctx is done
+func (c *Client) RecentEpisodesCtx(ctx context.Context, before int, max int, exclude string) ([]*Episode, error) {
+	return c.RecentEpisodesWithOptionsCtx(ctx, RecentEpisodesOptions{Before: before, Max: max, Exclude: exclude})
+}
+
+// RecentEpisodesOptions are the optional parameters of RecentEpisodes, the zero
+// value uses the API defaults
+type RecentEpisodesOptions struct {
+	// Before only returns episodes that are older than the episode with this id,
+	// 0 to ignore
+	Before int
+	// Max is the number of results, 0 uses the API default
+	Max int
+	// Exclude leaves out episodes with this string in the title or URL
+	Exclude string
+	// Since only returns episodes published after that time, e.g. to continue
+	// polling from the last seen publish time. The zero time does not filter.
+	Since time.Time
+}
+
+// RecentEpisodesWithOptions returns the last episodes across the entire
+// database like RecentEpisodes, with the given options
+func (c *Client) RecentEpisodesWithOptions(opts RecentEpisodesOptions) ([]*Episode, error) {
+	return c.RecentEpisodesWithOptionsCtx(context.Background(), opts)
+}
+
+// RecentEpisodesWithOptionsCtx works like RecentEpisodesWithOptions, it is
+// canceled when ctx is done
+func (c *Client) RecentEpisodesWithOptionsCtx(ctx context.Context, opts RecentEpisodesOptions) ([]*Episode, error) {
+	url := c.newURL("recent/episodes").fullText().max(opts.Max).
+		set("excludeString", opts.Exclude).int("before", opts.Before).since(opts.Since).String()
+	return c.getEpisodes(ctx, url, errors.New("Could not get recent episodes"))
 }
 
 // RecentPodcasts returns the last updated podcasts
@@ -215,77 +715,422 @@ func (c *Client) RecentEpisodes(before int, max int, exclude string) ([]*Episode
 // - since = only return episodes since that time. Set time to zero to not filter
 // by time
 func (c *Client) RecentPodcasts(languages, categories, notCategories []string, max int, since time.Time) ([]*RecentPodcast, error) {
-	url := fmt.Sprintf("recent/feeds?fulltext%s%s%s%s%s",
-		addMax(max), addFilter("lang", languages), addFilter("cat", categories),
-		addFilter("notcat", notCategories), addTime(since),
-	)
+	return c.RecentPodcastsCtx(context.Background(), languages, categories, notCategories, max, since)
+}
+
+// RecentPodcastsCtx works like RecentPodcasts, it is canceled when ctx is done
+func (c *Client) RecentPodcastsCtx(ctx context.Context, languages, categories, notCategories []string, max int, since time.Time) ([]*RecentPodcast, error) {
+	return c.recentPodcasts(ctx, languages, categories, notCategories, max, since)
+}
+
+func (c *Client) recentPodcasts(ctx context.Context, languages, categories, notCategories []string, max int, since time.Time) ([]*RecentPodcast, error) {
+	url := c.newURL("recent/feeds").fullText().max(max).
+		list("lang", languages).list("cat", categories).list("notcat", notCategories).
+		since(since).String()
 	result := &RecentPodcastsResponse{}
-	err := c.request(url, result)
+	err := c.requestContext(ctx, url, result)
 	if err != nil {
 		return nil, err
 	}
 	if result.Status == "false" {
-		return nil, errors.New("Could not find the recently updated podcasts")
+		if err := c.listNotFound(url, result.Description, errors.New("Could not find the recently updated podcasts")); err != nil {
+			return nil, err
+		}
+		result.Feeds = []*RecentPodcast{}
 	}
 	return result.Feeds, err
 }
 
+// MaxNewPodcasts is the maximum number of results recent/newfeeds returns per call
+const MaxNewPodcasts = 1000
+
 // NewPodcasts return up to 1000 podcasts that have been added to the database over the last week
 func (c *Client) NewPodcasts() ([]*NewPodcast, error) {
-	url := fmt.Sprintf("recent/newfeeds")
+	return c.NewPodcastsCtx(context.Background())
+}
+
+// NewPodcastsCtx works like NewPodcasts, it is canceled when ctx is done
+func (c *Client) NewPodcastsCtx(ctx context.Context) ([]*NewPodcast, error) {
+	return c.NewPodcastsWithOptionsCtx(ctx, NewPodcastsOptions{})
+}
+
+// NewPodcastsC returns the podcasts that have been added to the database over the
+// last week, with the parameters to page through them
+//
+// - max = number of podcasts to return, if max is 0 the default number of podcasts will be
+// returned. The API returns at most MaxNewPodcasts, a higher max is an error
+//
+// - since = only return podcasts added since that time. Set time to zero to not filter
+// by time
+//
+// - feedID = start the results at the podcast with this id, set to zero to ignore.
+// Pass the id of the last podcast of the previous call to get the next page
+//
+// Deprecated: Use NewPodcastsWithOptions, which takes all options of the
+// endpoint including Desc.
+func (c *Client) NewPodcastsC(max int, since time.Time, feedID int) ([]*NewPodcast, error) {
+	return c.NewPodcastsWithOptions(NewPodcastsOptions{Max: max, Since: since, FeedID: feedID})
+}
+
+// NewPodcastsOptions are the optional parameters of NewPodcasts, the zero value
+// uses the API defaults
+type NewPodcastsOptions struct {
+	// Max is the number of results, 0 uses the API default. The API returns at
+	// most MaxNewPodcasts, a higher Max is an error.
+	Max int
+	// Since only returns podcasts added since that time, the zero time does not
+	// filter
+	Since time.Time
+	// FeedID starts the results at the podcast with this id, 0 to ignore. Pass
+	// the id of the last podcast of the previous call to get the next page.
+	FeedID int
+	// Desc returns the newest podcasts first instead of the oldest
+	Desc bool
+}
+
+// NewPodcastsWithOptions returns the podcasts that have been added to the
+// database over the last week like NewPodcastsC, with the given options
+func (c *Client) NewPodcastsWithOptions(opts NewPodcastsOptions) ([]*NewPodcast, error) {
+	return c.NewPodcastsWithOptionsCtx(context.Background(), opts)
+}
+
+// NewPodcastsWithOptionsCtx works like NewPodcastsWithOptions, it is canceled
+// when ctx is done
+func (c *Client) NewPodcastsWithOptionsCtx(ctx context.Context, opts NewPodcastsOptions) ([]*NewPodcast, error) {
+	max := opts.Max
+	if max == 0 {
+		max = c.defaultMax
+	}
+	if max > MaxNewPodcasts {
+		return nil, fmt.Errorf("%w: max can not be higher than %d", ErrInvalidArgument, MaxNewPodcasts)
+	}
+	url := c.newURL("recent/newfeeds").max(max).since(opts.Since).
+		int("feedid", opts.FeedID).flag("desc", opts.Desc).String()
 	result := &NewPodcastResponse{}
-	err := c.request(url, result)
+	err := c.requestContext(ctx, url, result)
 	if err != nil {
 		return nil, err
 	}
 	if result.Status == "false" {
-		return nil, errors.New("Could not find the newest podcasts")
+		if err := c.listNotFound(url, result.Description, errors.New("Could not find the newest podcasts")); err != nil {
+			return nil, err
+		}
+		result.Feeds = []*NewPodcast{}
 	}
 	return result.Feeds, err
 }
 
-func (c *Client) Categories() ( []*Category,error) {
-	url := fmt.Sprintf("categories/list")
+// Categories returns all categories known to the API, see CachedCategories
+// for a cached version
+func (c *Client) Categories() ([]*Category, error) {
+	return c.CategoriesCtx(context.Background())
+}
+
+// CategoriesCtx works like Categories, it is canceled when ctx is done
+func (c *Client) CategoriesCtx(ctx context.Context) ([]*Category, error) {
+	return c.categoriesContext(ctx)
+}
+
+func (c *Client) categoriesContext(ctx context.Context) ([]*Category, error) {
+	url := c.newURL("categories/list").String()
 	result := &CategoryArrayResponse{}
-	err := c.request(url, result)
+	err := c.requestContext(ctx, url, result)
 	if err != nil {
 		return nil, err
 	}
 	if result.Status == "false" {
-		return nil, errors.New("Could not find the newest podcasts")
+		if err := c.listNotFound(url, result.Description, errors.New("Could not find the categories")); err != nil {
+			return nil, err
+		}
+		result.Feeds = []*Category{}
 	}
 
 	return result.Feeds, err
 }
 
 // PodcastsTrending returns the top max podcasts by their popularity
+//
+// - languages = the languages the podcast should be in. "unknown" for when the language is not known.
+// Leave empty if languages does not matter
+//
+// - categories = name of the category or categories the podcast should be in.
+// Leave empty if categories do not matter
+//
+// - notCategories = name of the category or categories the podcast should not be in.
+// Leave empty if categories do not matter
+//
+// - max = number of podcasts to return, if max is 0 the default number of podcasts will be
+// returned, the default is 10
+//
+// - since = start of the window the popularity is measured in, the window always ends
+// now. It is not a cutoff for the podcasts themselves, older podcasts are returned
+// when they were popular since then. The API has no end of the window, for a
+// window like "last week" pass time.Now().Add(-7 * 24 * time.Hour). Set time to zero
+// to use the API default
 func (c *Client) PodcastsTrending(languages, categories, notCategories []string, max int, since time.Time) ([]*Podcast, error) {
-	url := fmt.Sprintf("podcasts/trending?fulltext%s%s%s%s%s",
-	addMax(max), addFilter("lang", languages), addFilter("cat", categories),
-	addFilter("notcat", notCategories), addTime(since))
+	return c.PodcastsTrendingCtx(context.Background(), languages, categories, notCategories, max, since)
+}
+
+// PodcastsTrendingCtx works like PodcastsTrending, it is canceled when ctx is
+// done
+func (c *Client) PodcastsTrendingCtx(ctx context.Context, languages, categories, notCategories []string, max int, since time.Time) ([]*Podcast, error) {
+	result, err := c.PodcastsTrendingWithMetaCtx(ctx, languages, categories, notCategories, max, since)
+	if err != nil {
+		return nil, err
+	}
+	return result.Feeds, nil
+}
+
+// PodcastsTrendingWithMeta works like PodcastsTrending, but returns the complete
+// result including the count and the description of the API
+func (c *Client) PodcastsTrendingWithMeta(languages, categories, notCategories []string, max int, since time.Time) (*PodcastsTrendingResponse, error) {
+	return c.PodcastsTrendingWithMetaCtx(context.Background(), languages, categories, notCategories, max, since)
+}
+
+// PodcastsTrendingWithMetaCtx works like PodcastsTrendingWithMeta, it is
+// canceled when ctx is done
+func (c *Client) PodcastsTrendingWithMetaCtx(ctx context.Context, languages, categories, notCategories []string, max int, since time.Time) (*PodcastsTrendingResponse, error) {
+	return c.podcastsTrending(ctx, TrendingOptions{
+		Languages:     languages,
+		Categories:    categories,
+		NotCategories: notCategories,
+		Max:           max,
+		Since:         since,
+	})
+}
+
+// TrendingOptions are the optional parameters of PodcastsTrending, the zero
+// value uses the API defaults
+type TrendingOptions struct {
+	// Languages the podcasts should be in, "unknown" for when the language is
+	// not known
+	Languages []string
+	// Categories the podcasts should be in, by name or id
+	Categories []string
+	// NotCategories the podcasts should not be in, by name or id
+	NotCategories []string
+	// Max is the number of results, 0 uses the API default
+	Max int
+	// Since is the start of the window the popularity is measured in, see
+	// PodcastsTrending. The zero time uses the API default.
+	Since time.Time
+}
+
+// PodcastsTrendingWithOptions returns the podcasts that are trending like
+// PodcastsTrending, with the given options
+func (c *Client) PodcastsTrendingWithOptions(opts TrendingOptions) ([]*Podcast, error) {
+	return c.PodcastsTrendingWithOptionsCtx(context.Background(), opts)
+}
+
+// PodcastsTrendingWithOptionsCtx works like PodcastsTrendingWithOptions, it is
+// canceled when ctx is done
+func (c *Client) PodcastsTrendingWithOptionsCtx(ctx context.Context, opts TrendingOptions) ([]*Podcast, error) {
+	result, err := c.podcastsTrending(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.Feeds, nil
+}
+
+func (c *Client) podcastsTrending(ctx context.Context, opts TrendingOptions) (*PodcastsTrendingResponse, error) {
+	url := c.newURL("podcasts/trending").fullText().valueFilter().max(opts.Max).
+		list("lang", opts.Languages).list("cat", opts.Categories).list("notcat", opts.NotCategories).
+		since(opts.Since).String()
 
 	result := &PodcastsTrendingResponse{}
-	err := c.request(url, result)
+	err := c.requestContext(ctx, url, result)
 	if err != nil {
 		return nil, err
 	}
 	if result.Status == "false" {
-		return nil, errors.New("Could not find the trending podcasts")
+		if err := c.listNotFound(url, result.Description, errors.New("Could not find the trending podcasts")); err != nil {
+			return nil, err
+		}
+		result.Feeds = []*Podcast{}
 	}
-	return result.Feeds, err
+	return result, nil
+}
+
+// PodcastsByMedium returns podcasts with the given medium, e.g. MediumMusic or
+// MediumAudiobook
+//
+// - max = number of podcasts to return, if max is 0 the default number of podcasts will be
+// returned
+func (c *Client) PodcastsByMedium(medium Medium, max int) ([]*Podcast, error) {
+	return c.PodcastsByMediumCtx(context.Background(), medium, max)
+}
+
+// PodcastsByMediumCtx works like PodcastsByMedium, it is canceled when ctx is
+// done
+func (c *Client) PodcastsByMediumCtx(ctx context.Context, medium Medium, max int) ([]*Podcast, error) {
+	if err := required("medium", string(medium)); err != nil {
+		return nil, err
+	}
+	url := c.newURL("podcasts/bymedium").set("medium", string(medium)).fullText().max(max).String()
+	result := &PodcastsByMediumResponse{}
+	err := c.requestContext(ctx, url, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Status == "false" {
+		if err := c.listNotFound(url, result.Description, errors.New("Could not find podcasts for that medium")); err != nil {
+			return nil, err
+		}
+		result.Feeds = []*Podcast{}
+	}
+	return result.Feeds, nil
+}
+
+// DeadPodcasts returns all feeds that are marked as dead in the index, e.g. to
+// remove them from a local database
+func (c *Client) DeadPodcasts() ([]*DeadPodcast, error) {
+	return c.DeadPodcastsCtx(context.Background())
+}
+
+// DeadPodcastsCtx works like DeadPodcasts, it is canceled when ctx is done
+func (c *Client) DeadPodcastsCtx(ctx context.Context) ([]*DeadPodcast, error) {
+	url := c.newURL("podcasts/dead").String()
+	result := &DeadPodcastsResponse{}
+	err := c.requestContext(ctx, url, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Status == "false" {
+		if err := c.listNotFound(url, result.Description, errors.New("Could not get the dead podcasts")); err != nil {
+			return nil, err
+		}
+		result.Feeds = []*DeadPodcast{}
+	}
+	return result.Feeds, nil
+}
+
+// EpisodesTrending returns the latest episode of each of the top max trending
+// podcasts. The API has no trending endpoint for episodes, so this is a heuristic
+// built on PodcastsTrending, with one additional request per podcast.
+//
+// - since = only consider the popularity since that time and only return episodes
+// published after it. Set time to zero to not filter by time
+func (c *Client) EpisodesTrending(languages, categories []string, max int, since time.Time) ([]*Episode, error) {
+	return c.EpisodesTrendingCtx(context.Background(), languages, categories, max, since)
+}
+
+// EpisodesTrendingCtx works like EpisodesTrending, it is canceled when ctx is
+// done
+func (c *Client) EpisodesTrendingCtx(ctx context.Context, languages, categories []string, max int, since time.Time) ([]*Episode, error) {
+	feeds, err := c.PodcastsTrendingCtx(ctx, languages, categories, nil, max, since)
+	if err != nil {
+		return nil, err
+	}
+	episodes := make([]*Episode, 0, len(feeds))
+	for _, feed := range feeds {
+		latest, err := c.EpisodesByFeedIDCtx(ctx, fmt.Sprintf("%d", feed.ID), 1, since)
+		if err != nil {
+			return nil, err
+		}
+		if len(latest) > 0 {
+			episodes = append(episodes, latest[0])
+		}
+	}
+	return episodes, nil
 }
 
+// AddByFeedURL adds the podcast with the given feed URL to the index and returns
+// its feed id.
+//
+// The call is safe to retry: it is a GET request and adding a feed which is
+// already in the index does not create a second entry, the API returns the id
+// of the existing feed instead.
 func (c *Client) AddByFeedURL(feedURL string) (int, error) {
-	url := fmt.Sprintf("add/byfeedurl?url=%s", feedURL)
+	return c.AddByFeedURLCtx(context.Background(), feedURL)
+}
+
+// AddByFeedURLCtx works like AddByFeedURL, it is canceled when ctx is done
+func (c *Client) AddByFeedURLCtx(ctx context.Context, feedURL string) (int, error) {
+	if err := required("feedURL", feedURL); err != nil {
+		return 0, err
+	}
+	url := c.newURL("add/byfeedurl").set("url", feedURL).String()
 
 	result := &AddByFeedURLResponse{}
-	err := c.request(url, result)
+	err := c.requestContext(ctx, url, result)
 	if err != nil {
 		return 0, err
 	}
 	if result.Status == "false" {
-		return 0, errors.New("Could not add podcast by feed URL")
+		return 0, newStatusError(url, result.Description, errors.New("Could not add podcast by feed URL"))
 	}
 
 	return result.FeedId, nil
 }
+
+// AddByITunesID adds the podcast with the given iTunes id to the index and
+// returns its feed id. existed reports if the podcast was already in the index,
+// then the id of the existing feed is returned. Like AddByFeedURL it is safe to
+// retry.
+func (c *Client) AddByITunesID(id int64) (feedID int, existed bool, err error) {
+	return c.AddByITunesIDCtx(context.Background(), id)
+}
+
+// AddByITunesIDCtx works like AddByITunesID, it is canceled when ctx is done
+func (c *Client) AddByITunesIDCtx(ctx context.Context, id int64) (feedID int, existed bool, err error) {
+	if err := positive("id", id); err != nil {
+		return 0, false, err
+	}
+	url := c.newURL("add/byitunesid").set("id", strconv.FormatInt(id, 10)).String()
+
+	result := &AddByFeedURLResponse{}
+	err = c.requestContext(ctx, url, result)
+	if err != nil {
+		return 0, false, err
+	}
+	if result.Status == "false" {
+		return 0, false, newStatusError(url, result.Description, errors.New("Could not add podcast by iTunes id"))
+	}
+
+	return result.FeedId, result.Existed, nil
+}
+
+// NotifyFeedUpdated tells the index that the feed with the given id changed, so
+// it is crawled again soon instead of at the next regular poll. It is meant for
+// hosting platforms after publishing an episode.
+func (c *Client) NotifyFeedUpdated(feedID int64) error {
+	return c.NotifyFeedUpdatedCtx(context.Background(), feedID)
+}
+
+// NotifyFeedUpdatedCtx works like NotifyFeedUpdated, it is canceled when ctx is
+// done
+func (c *Client) NotifyFeedUpdatedCtx(ctx context.Context, feedID int64) error {
+	if err := positive("feedID", feedID); err != nil {
+		return err
+	}
+	url := c.newURL("hub/pubnotify").set("id", strconv.FormatInt(feedID, 10)).String()
+	return c.pubNotify(ctx, url)
+}
+
+// NotifyFeedURLUpdated works like NotifyFeedUpdated, for the feed with the given
+// URL
+func (c *Client) NotifyFeedURLUpdated(feedURL string) error {
+	return c.NotifyFeedURLUpdatedCtx(context.Background(), feedURL)
+}
+
+// NotifyFeedURLUpdatedCtx works like NotifyFeedURLUpdated, it is canceled when
+// ctx is done
+func (c *Client) NotifyFeedURLUpdatedCtx(ctx context.Context, feedURL string) error {
+	if err := required("feedURL", feedURL); err != nil {
+		return err
+	}
+	url := c.newURL("hub/pubnotify").set("url", feedURL).String()
+	return c.pubNotify(ctx, url)
+}
+
+func (c *Client) pubNotify(ctx context.Context, url string) error {
+	result := &PubNotifyResponse{}
+	err := c.requestContext(ctx, url, result)
+	if err != nil {
+		return err
+	}
+	if result.Status == "false" {
+		return newStatusError(url, result.Description, errors.New("Could not notify about the feed update"))
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPodcastsTrendingWindow(t *testing.T) {
+	weekAgo := time.Now().Add(-7 * 24 * time.Hour).Truncate(time.Second)
+	tests := []struct {
+		name string
+		call func(c *Client) error
+		want url.Values
+	}{
+		{
+			name: "API default window",
+			call: func(c *Client) error {
+				_, err := c.PodcastsTrending(nil, nil, nil, 0, time.Time{})
+				return err
+			},
+			want: url.Values{"fulltext": {""}},
+		},
+		{
+			name: "last week",
+			call: func(c *Client) error {
+				_, err := c.PodcastsTrending(nil, nil, nil, 10, weekAgo)
+				return err
+			},
+			want: url.Values{"fulltext": {""}, "max": {"10"}, "since": {strconv.FormatInt(weekAgo.Unix(), 10)}},
+		},
+		{
+			name: "window with filters",
+			call: func(c *Client) error {
+				_, err := c.PodcastsTrendingWithOptions(TrendingOptions{
+					Languages:     []string{"en", "de"},
+					Categories:    []string{"News"},
+					NotCategories: []string{"Sports"},
+					Since:         weekAgo,
+				})
+				return err
+			},
+			want: url.Values{"fulltext": {""}, "lang": {"en,de"}, "cat": {"News"}, "notcat": {"Sports"},
+				"since": {strconv.FormatInt(weekAgo.Unix(), 10)}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got url.Values
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/podcasts/trending" {
+					t.Errorf("path = %q", r.URL.Path)
+				}
+				got = r.URL.Query()
+				respond(http.StatusOK, `{"status":"true","feeds":[{"id":1,"trendScore":9}]}`)(w, r)
+			})
+			if err := test.call(c); err != nil {
+				t.Fatal(err)
+			}
+			if got.Encode() != test.want.Encode() {
+				t.Errorf("query = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAllEpisodesForFeedTruncated(t *testing.T) {
+	tests := []struct {
+		episodes int
+		want     int
+		err      error
+	}{
+		{3, 3, nil},
+		{MaxEpisodes - 1, MaxEpisodes - 1, nil},
+		{2500, MaxEpisodes, ErrEpisodesTruncated},
+	}
+	for _, test := range tests {
+		t.Run(strconv.Itoa(test.episodes), func(t *testing.T) {
+			published := make([]int64, test.episodes)
+			for i := range published {
+				published[i] = int64(test.episodes - i)
+			}
+			c, maxs := feedServer(t, map[string][]int64{"1": published})
+			episodes, err := c.AllEpisodesForFeed(context.Background(), "1")
+			if !errors.Is(err, test.err) || (test.err == nil && err != nil) {
+				t.Fatalf("err = %v, want %v", err, test.err)
+			}
+			if len(episodes) != test.want {
+				t.Errorf("got %d episodes, want %d", len(episodes), test.want)
+			}
+			if reqs := maxs()["1"]; len(reqs) != 1 || reqs[0] != MaxEpisodes {
+				t.Errorf("requests with max %v, want one with %d", reqs, MaxEpisodes)
+			}
+			for i := 1; i < len(episodes); i++ {
+				if time.Time(episodes[i].DatePublished).After(time.Time(episodes[i-1].DatePublished)) {
+					t.Fatalf("episode %d is newer than the one before", i)
+				}
+			}
+		})
+	}
+}
+
+func TestNewPodcastsMax(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ClientOption
+		max  int
+		err  bool
+	}{
+		{"API default", nil, 0, false},
+		{"limit", nil, MaxNewPodcasts, false},
+		{"above the limit", nil, MaxNewPodcasts + 1, true},
+		{"default max above the limit", []ClientOption{WithDefaultMax(MaxNewPodcasts + 1)}, 0, true},
+		{"explicit max wins over the default", []ClientOption{WithDefaultMax(MaxNewPodcasts + 1)}, 10, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := 0
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				respond(http.StatusOK, `{"status":"true","feeds":[]}`)(w, r)
+			}, test.opts...)
+			_, err := c.NewPodcastsWithOptions(NewPodcastsOptions{Max: test.max})
+			if test.err {
+				if !errors.Is(err, ErrInvalidArgument) || requests != 0 {
+					t.Errorf("got %v after %d requests, want ErrInvalidArgument", err, requests)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestEpisodesInRange(t *testing.T) {
+	from, to := time.Unix(1000, 0), time.Unix(2000, 0)
+	var since []string
+	published := []int64{2001, 2000, 1500, 1000, 999}
+	c, _ := feedServer(t, map[string][]int64{"1": published}, WithRequestHook(func(r *http.Request) {
+		since = append(since, r.URL.Query().Get("since"))
+	}))
+	tests := []struct {
+		name  string
+		from  time.Time
+		since string
+		want  []string
+	}{
+		{"both boundaries", from, "999", []string{"1-2000", "1-1500", "1-1000"}},
+		{"zero from", time.Time{}, "", []string{"1-2000", "1-1500", "1-1000", "1-999"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			since = nil
+			episodes, err := c.EpisodesInRange(context.Background(), "1", test.from, to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, e := range episodes {
+				got = append(got, e.GUID)
+			}
+			if strings.Join(got, " ") != strings.Join(test.want, " ") {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+			if len(since) != 1 || since[0] != test.since {
+				t.Errorf("since = %q, want %q", since, test.since)
+			}
+		})
+	}
+}
+
+func TestEpisodesByNumberRange(t *testing.T) {
+	// newest first like the API, season 2 restarts the numbering, numbers 4 and
+	// 6 of season 1 are missing and two episodes have no number
+	items := `[
+		{"title":"s2e2","season":2,"episode":2},
+		{"title":"s2e1","season":2,"episode":1},
+		{"title":"trailer","season":2},
+		{"title":"s1e7","season":1,"episode":7},
+		{"title":"s1e5","season":1,"episode":5},
+		{"title":"bonus"},
+		{"title":"s1e3","season":1,"episode":3},
+		{"title":"s1e2","season":1,"episode":2},
+		{"title":"s1e1","season":1,"episode":1}
+	]`
+	tests := []struct {
+		name             string
+		season, from, to int
+		want             []string
+	}{
+		{"range with gaps", 1, 3, 7, []string{"s1e3", "s1e5", "s1e7"}},
+		{"all seasons", 0, 2, 5, []string{"s1e2", "s1e3", "s1e5", "s2e2"}},
+		{"single missing number", 1, 4, 4, nil},
+		{"beyond the last number", 1, 8, 20, nil},
+		{"second season", 2, 1, 10, []string{"s2e1", "s2e2"}},
+		{"unknown season", 3, 1, 10, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := newTestClient(t, respond(http.StatusOK, `{"status":"true","items":`+items+`}`))
+			episodes, err := c.EpisodesByNumberRange(context.Background(), "1", test.season, test.from, test.to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := titles(episodes)
+			if strings.Join(got, " ") != strings.Join(test.want, " ") {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEpisodesByNumberRangeInvalid(t *testing.T) {
+	c := NewClient("key", "secret")
+	if _, err := c.EpisodesByNumberRange(context.Background(), "1", 0, 5, 4); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("got %v, want ErrInvalidArgument", err)
+	}
+}
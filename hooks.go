@@ -0,0 +1,46 @@
+package podcastindex
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// WithRequestHook calls hook with every request to the API before it is sent,
+// after the authentication headers were set, e.g. to add headers or to log
+// requests. Hooks are called in the order they were added.
+func WithRequestHook(hook func(*http.Request)) ClientOption {
+	return func(c *Client) {
+		if hook != nil {
+			c.requestHooks = append(c.requestHooks, hook)
+		}
+	}
+}
+
+// WithResponseHook calls hook with every response of the API, e.g. for metrics
+// or to capture responses. The body is already read, hook gets a copy of it
+// which it may read as well. When the request failed the response is nil or
+// incomplete and err is set. Hooks are called in the order they were added.
+func WithResponseHook(hook func(*http.Response, error)) ClientOption {
+	return func(c *Client) {
+		if hook != nil {
+			c.responseHooks = append(c.responseHooks, hook)
+		}
+	}
+}
+
+func (c *Client) onRequest(req *http.Request) {
+	for _, hook := range c.requestHooks {
+		hook(req)
+	}
+}
+
+// onResponse calls the response hooks, every hook gets its own reader of body
+func (c *Client) onResponse(res *http.Response, body []byte, err error) {
+	for _, hook := range c.responseHooks {
+		if res != nil && body != nil {
+			res.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		hook(res, err)
+	}
+}
@@ -0,0 +1,81 @@
+package podcastindex
+
+import (
+	"html"
+	"strings"
+)
+
+// blockTags are replaced by a space, so that words of different paragraphs or
+// list items are not glued together
+var blockTags = map[string]bool{
+	"br": true, "p": true, "div": true, "li": true, "ul": true, "ol": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"tr": true, "td": true, "th": true, "blockquote": true, "hr": true,
+}
+
+// stripHTML removes all tags from s, drops the content of script and style
+// elements, decodes entities and collapses whitespace
+func stripHTML(s string) string {
+	var b strings.Builder
+	skip := ""
+	for len(s) > 0 {
+		start := strings.IndexByte(s, '<')
+		if start < 0 {
+			if skip == "" {
+				b.WriteString(s)
+			}
+			break
+		}
+		if skip == "" {
+			b.WriteString(s[:start])
+		}
+		if !isTagStart(s[start+1:]) {
+			// not a tag, e.g. "a < b"
+			if skip == "" {
+				b.WriteByte('<')
+			}
+			s = s[start+1:]
+			continue
+		}
+		end := strings.IndexByte(s[start:], '>')
+		if end < 0 {
+			if skip == "" {
+				b.WriteString(s[start:])
+			}
+			break
+		}
+		name, closing := tagName(s[start+1 : start+end])
+		s = s[start+end+1:]
+		switch {
+		case skip != "":
+			if closing && name == skip {
+				skip = ""
+			}
+		case !closing && (name == "script" || name == "style"):
+			skip = name
+		case blockTags[name]:
+			b.WriteByte(' ')
+		}
+	}
+	return strings.Join(strings.Fields(html.UnescapeString(b.String())), " ")
+}
+
+// tagName returns the lower case name of the tag with the content t, e.g.
+// "a href=..." or "/p", and if it is a closing tag
+func tagName(t string) (string, bool) {
+	closing := strings.HasPrefix(t, "/")
+	t = strings.TrimPrefix(t, "/")
+	if i := strings.IndexAny(t, " \t\r\n/"); i >= 0 {
+		t = t[:i]
+	}
+	return strings.ToLower(t), closing
+}
+
+// isTagStart reports if s, the text after a "<", starts a tag or comment
+func isTagStart(s string) bool {
+	if s == "" {
+		return false
+	}
+	c := s[0]
+	return c == '/' || c == '!' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
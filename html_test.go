@@ -0,0 +1,41 @@
+package podcastindex
+
+import "testing"
+
+func TestStripHTML(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"plain text", "plain text"},
+		{"<p>One</p><p>Two</p>", "One Two"},
+		{"<div><p>Nested <b>bold <i>and italic</i></b> text</p></div>", "Nested bold and italic text"},
+		{"<ul><li>first</li><li>second<br/>line</li></ul>", "first second line"},
+		{"Tom &amp; Jerry", "Tom & Jerry"},
+		{"<p>Q&amp;A with &quot;guests&quot; &#8211; part&nbsp;2</p>", "Q&A with \"guests\" – part 2"},
+		{"&amp;lt;p&amp;gt; stays text", "&lt;p&gt; stays text"},
+		{"&lt;b&gt;escaped&lt;/b&gt;", "<b>escaped</b>"},
+		{`<a href="https://example.com/?a=1&amp;b=2">link</a>`, "link"},
+		{"<script>alert(1)</script>after<style>p{}</style>", "after"},
+		{"a < b and c > d", "a < b and c > d"},
+		{"<!-- comment -->text", "text"},
+		{"broken <b", "broken <b"},
+		{"  lots \n\t of   space  ", "lots of space"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if got := stripHTML(test.in); got != test.want {
+			t.Errorf("stripHTML(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestPlainDescription(t *testing.T) {
+	e := &Episode{Description: "<p>Show notes &amp; <a href=\"#\">links</a></p>"}
+	if got := e.PlainDescription(); got != "Show notes & links" {
+		t.Errorf("episode: got %q", got)
+	}
+	p := &Podcast{Description: "<div><p>About <em>us</em></p></div>"}
+	if got := p.PlainDescription(); got != "About us" {
+		t.Errorf("podcast: got %q", got)
+	}
+}
@@ -0,0 +1,84 @@
+// Command contract verifies the recorded API responses in testdata against the
+// library types.
+//
+//	go run ./internal/contract/cmd/contract
+//
+// With -regen the fixtures are recorded again from the live API. This needs
+// credentials in PODCASTINDEX_API_KEY and PODCASTINDEX_API_SECRET:
+//
+//	go run ./internal/contract/cmd/contract -regen
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/koalahl/podcastindex-go"
+	"github.com/koalahl/podcastindex-go/internal/contract"
+)
+
+func main() {
+	dir := flag.String("dir", "testdata", "directory containing the fixtures")
+	regen := flag.Bool("regen", false, "record the fixtures again from the live API")
+	flag.Parse()
+
+	if *regen {
+		if err := regenerate(*dir); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := contract.Verify(*dir); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%d fixtures ok\n", len(contract.Fixtures))
+}
+
+func regenerate(dir string) error {
+	key, secret := os.Getenv("PODCASTINDEX_API_KEY"), os.Getenv("PODCASTINDEX_API_SECRET")
+	if key == "" || secret == "" {
+		return fmt.Errorf("PODCASTINDEX_API_KEY and PODCASTINDEX_API_SECRET have to be set")
+	}
+	rec := &recorder{}
+	c := podcastindex.NewClientWithConfig(key, secret, *podcastindex.DefaultConfig, &http.Client{Transport: rec})
+	for _, f := range contract.Fixtures {
+		rec.body = nil
+		if err := f.Fetch(c); err != nil {
+			return fmt.Errorf("%s: %w", f.Name, err)
+		}
+		var out bytes.Buffer
+		if err := json.Indent(&out, rec.body, "", "  "); err != nil {
+			return fmt.Errorf("%s: %w", f.Name, err)
+		}
+		out.WriteByte('\n')
+		if err := os.WriteFile(filepath.Join(dir, f.Name), out.Bytes(), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recorder keeps a copy of the last response body
+type recorder struct {
+	body []byte
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	r.body, err = io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(r.body))
+	return res, nil
+}
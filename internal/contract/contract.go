@@ -0,0 +1,230 @@
+// Package contract checks recorded API responses against the types of the
+// podcastindex package. Every fixture is decoded into its result type and
+// encoded again, any field of the recording that does not survive this round
+// trip is reported, because it is silently dropped by the library.
+package contract
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/koalahl/podcastindex-go"
+)
+
+// Fixture describes a single recorded API response
+type Fixture struct {
+	// Name of the file inside the fixture directory
+	Name string
+	// New returns the value the response is decoded into
+	New func() interface{}
+	// Fetch calls the live API, it is only used to regenerate the fixture
+	Fetch func(c *podcastindex.Client) error
+	// Ignore lists paths that are known to not be modelled, e.g. "feeds[].funding"
+	Ignore []string
+}
+
+// Fixtures contains all recorded responses that are checked
+var Fixtures = []Fixture{
+	{
+		Name: "search_byterm.json",
+		New:  func() interface{} { return &podcastindex.PodcastArrayResult{} },
+		Fetch: func(c *podcastindex.Client) error {
+			_, err := c.SearchPodcastsC("batman university", false, 2)
+			return err
+		},
+	},
+	{
+		Name: "podcasts_byfeedid.json",
+		New:  func() interface{} { return &podcastindex.PodcastResult{} },
+		Fetch: func(c *podcastindex.Client) error {
+			_, err := c.PodcastByFeedID("75075")
+			return err
+		},
+	},
+	{
+		Name: "episodes_byfeedid.json",
+		New:  func() interface{} { return &podcastindex.EpisodeArrayResponse{} },
+		Fetch: func(c *podcastindex.Client) error {
+			_, err := c.EpisodesByFeedID("75075", 2, time.Time{})
+			return err
+		},
+	},
+	{
+		Name: "episodes_byfeedid_live.json",
+		New:  func() interface{} { return &podcastindex.EpisodeArrayResponse{} },
+		Fetch: func(c *podcastindex.Client) error {
+			_, err := c.LiveItemsForFeed(context.Background(), "920666")
+			return err
+		},
+	},
+	{
+		Name: "episodes_byid.json",
+		New:  func() interface{} { return &podcastindex.EpisodeResponse{} },
+		Fetch: func(c *podcastindex.Client) error {
+			_, err := c.EpisodeByID("16795090")
+			return err
+		},
+	},
+	{
+		Name: "episodes_random.json",
+		New:  func() interface{} { return &podcastindex.RandomEpisodesResponse{} },
+		Fetch: func(c *podcastindex.Client) error {
+			_, err := c.RandomEpisodes(nil, nil, nil, 1)
+			return err
+		},
+	},
+	{
+		Name: "recent_feeds.json",
+		New:  func() interface{} { return &podcastindex.RecentPodcastsResponse{} },
+		Fetch: func(c *podcastindex.Client) error {
+			_, err := c.RecentPodcasts(nil, nil, nil, 2, time.Time{})
+			return err
+		},
+	},
+	{
+		Name: "recent_newfeeds.json",
+		New:  func() interface{} { return &podcastindex.NewPodcastResponse{} },
+		Fetch: func(c *podcastindex.Client) error {
+			_, err := c.NewPodcasts()
+			return err
+		},
+	},
+	{
+		Name: "categories_list.json",
+		New:  func() interface{} { return &podcastindex.CategoryArrayResponse{} },
+		Fetch: func(c *podcastindex.Client) error {
+			_, err := c.Categories()
+			return err
+		},
+	},
+	{
+		Name: "podcasts_trending.json",
+		New:  func() interface{} { return &podcastindex.PodcastsTrendingResponse{} },
+		Fetch: func(c *podcastindex.Client) error {
+			_, err := c.PodcastsTrending(nil, nil, nil, 2, time.Time{})
+			return err
+		},
+	},
+}
+
+// Load reads the fixture with the given name from dir
+func Load(dir, name string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(dir, name))
+}
+
+// Dropped decodes raw into v and returns the paths of all fields in raw that
+// are lost when v is encoded again. Fields which are null in raw are not
+// reported.
+func Dropped(raw []byte, v interface{}) ([]string, error) {
+	if err := json.Unmarshal(raw, v); err != nil {
+		return nil, err
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var before, after interface{}
+	if err := decodeGeneric(raw, &before); err != nil {
+		return nil, err
+	}
+	if err := decodeGeneric(encoded, &after); err != nil {
+		return nil, err
+	}
+	var dropped []string
+	compare("", before, after, &dropped)
+	sort.Strings(dropped)
+	return unique(dropped), nil
+}
+
+// Verify checks all fixtures inside dir and returns an error describing every
+// dropped field
+func Verify(dir string) error {
+	var problems []string
+	for _, f := range Fixtures {
+		raw, err := Load(dir, f.Name)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		dropped, err := Dropped(raw, f.New())
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", f.Name, err))
+			continue
+		}
+		for _, path := range dropped {
+			if !ignored(f.Ignore, path) {
+				problems = append(problems, fmt.Sprintf("%s: %s is not decoded", f.Name, path))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+func decodeGeneric(in []byte, out interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(in))
+	decoder.UseNumber()
+	return decoder.Decode(out)
+}
+
+// compare records every key of before that has no counterpart in after.
+// Array indices are collapsed to [] so that paths can be used in Ignore.
+func compare(path string, before, after interface{}, dropped *[]string) {
+	switch b := before.(type) {
+	case map[string]interface{}:
+		a, _ := after.(map[string]interface{})
+		for key, value := range b {
+			p := key
+			if path != "" {
+				p = path + "." + key
+			}
+			if value == nil {
+				continue
+			}
+			next, ok := a[key]
+			if !ok {
+				*dropped = append(*dropped, p)
+				continue
+			}
+			compare(p, value, next, dropped)
+		}
+	case []interface{}:
+		a, _ := after.([]interface{})
+		for i, value := range b {
+			if i >= len(a) {
+				*dropped = append(*dropped, path+"[]")
+				return
+			}
+			compare(path+"[]", value, a[i], dropped)
+		}
+	}
+}
+
+func ignored(list []string, path string) bool {
+	for _, i := range list {
+		if i == path {
+			return true
+		}
+	}
+	return false
+}
+
+func unique(sorted []string) []string {
+	var out []string
+	for _, s := range sorted {
+		if len(out) == 0 || out[len(out)-1] != s {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,123 @@
+package podcastindex
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+)
+
+// Credentials are an API key and its secret
+type Credentials struct {
+	Key    string
+	Secret string
+}
+
+// KeyRotation tells how the client picks one of several keys, see WithKeys
+type KeyRotation int
+
+const (
+	// RoundRobin uses the keys in turn for each request, to spread the load
+	RoundRobin KeyRotation = iota
+	// Failover uses the first key until it is rejected, then the next one
+	Failover
+)
+
+// WithKeys adds more credentials to the key passed to NewClient, e.g. to spread
+// the requests of a high volume service or to survive a key being revoked. The
+// key of NewClient comes first, unless it is empty. When the API rejects a key
+// with status 401 or 403 it is not used anymore and the request is retried
+// with the next key. Once all keys were rejected they are all tried again,
+// starting with the first.
+func WithKeys(rotation KeyRotation, keys ...Credentials) ClientOption {
+	return func(c *Client) {
+		all := make([]Credentials, 0, len(keys)+1)
+		if c.key != "" {
+			all = append(all, Credentials{Key: c.key, Secret: c.secret})
+		}
+		all = append(all, keys...)
+		if len(all) < 2 {
+			c.keys = nil
+			if len(all) == 1 {
+				c.key, c.secret = all[0].Key, all[0].Secret
+			}
+			return
+		}
+		c.keys = &keyRing{
+			rotation: rotation,
+			keys:     all,
+			rejected: make([]bool, len(all)),
+		}
+	}
+}
+
+// keyRing picks the credentials of each request
+type keyRing struct {
+	mu       sync.Mutex
+	rotation KeyRotation
+	keys     []Credentials
+	rejected []bool
+	next     int
+}
+
+// pick returns the index and the credentials for the next request
+func (r *keyRing) pick() (int, Credentials) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.available() == 0 {
+		for i := range r.rejected {
+			r.rejected[i] = false
+		}
+		r.next = 0
+	}
+	for {
+		i := r.next
+		if r.rotation == RoundRobin {
+			r.next = (r.next + 1) % len(r.keys)
+		}
+		if !r.rejected[i] {
+			return i, r.keys[i]
+		}
+		if r.rotation == Failover {
+			r.next = (r.next + 1) % len(r.keys)
+		}
+	}
+}
+
+// reject marks the key at i as rejected by the API and reports if another key
+// can be tried
+func (r *keyRing) reject(i int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rejected[i] = true
+	return r.available() > 0
+}
+
+func (r *keyRing) available() int {
+	n := 0
+	for _, rejected := range r.rejected {
+		if !rejected {
+			n++
+		}
+	}
+	return n
+}
+
+// credentials returns the key and secret for the next request and a function
+// to report the error of the request, which returns true when the request should
+// be retried with another key
+func (c *Client) credentials() (Credentials, func(error) bool) {
+	if c.keys == nil {
+		return Credentials{Key: c.key, Secret: c.secret}, func(error) bool { return false }
+	}
+	i, creds := c.keys.pick()
+	return creds, func(err error) bool {
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			return false
+		}
+		if apiErr.StatusCode != http.StatusUnauthorized && apiErr.StatusCode != http.StatusForbidden {
+			return false
+		}
+		return c.keys.reject(i)
+	}
+}
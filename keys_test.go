@@ -0,0 +1,108 @@
+package podcastindex
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// keyServer answers with status 401 for the rejected keys and records the key
+// of every request
+func keyServer(t *testing.T, rejected string, opts ...ClientOption) (*Client, func() []string) {
+	var (
+		mu   sync.Mutex
+		keys []string
+	)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("X-Auth-Key")
+		mu.Lock()
+		keys = append(keys, key)
+		mu.Unlock()
+		if strings.Contains(rejected, key) {
+			respond(http.StatusUnauthorized, `{"status":"false","description":"Authorization failed"}`)(w, r)
+			return
+		}
+		respond(http.StatusOK, `{"status":"true","feed":{"id":1}}`)(w, r)
+	}, opts...)
+	return c, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), keys...)
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	extra := []Credentials{{Key: "b", Secret: "y"}, {Key: "c", Secret: "z"}}
+	tests := []struct {
+		name     string
+		rotation KeyRotation
+		rejected string
+		want     []string
+	}{
+		{"round robin", RoundRobin, "", []string{"key", "b", "c", "key"}},
+		{"round robin skips rejected", RoundRobin, "b", []string{"key", "b", "c", "key", "c"}},
+		{"failover stays on the first key", Failover, "", []string{"key", "key", "key", "key"}},
+		{"failover moves on", Failover, "key", []string{"key", "b", "b", "b", "b"}},
+		{"failover twice", Failover, "key b", []string{"key", "b", "c", "c", "c", "c"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, keys := keyServer(t, test.rejected, WithKeys(test.rotation, extra...))
+			for i := 0; i < 4; i++ {
+				if _, err := c.PodcastByFeedID("1"); err != nil {
+					t.Fatalf("request %d: %s", i, err)
+				}
+			}
+			if got := keys(); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("keys = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAllKeysRejected(t *testing.T) {
+	c, keys := keyServer(t, "key b", WithKeys(Failover, Credentials{Key: "b", Secret: "y"}))
+	_, err := c.PodcastByFeedID("1")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("got %v, want status 401", err)
+	}
+	// all keys were rejected, so the next request starts over
+	c.PodcastByFeedID("1")
+	if got, want := keys(), []string{"key", "b", "key", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("keys = %v, want %v", got, want)
+	}
+}
+
+func TestWithKeysSingleKey(t *testing.T) {
+	if c := NewClient("key", "secret", WithKeys(RoundRobin)); c.keys != nil {
+		t.Error("one key enabled the rotation")
+	}
+	c, keys := keyServer(t, "", WithKeys(RoundRobin))
+	c.PodcastByFeedID("1")
+	if got := keys(); !reflect.DeepEqual(got, []string{"key"}) {
+		t.Errorf("keys = %v", got)
+	}
+}
+
+func TestWithKeysEmptyPrimary(t *testing.T) {
+	var key string
+	stub := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		key = r.Header.Get("X-Auth-Key")
+		respond(http.StatusOK, `{"status":"true","feed":{"id":1}}`)(w, r)
+	})
+	c := NewClient("", "", WithBaseURL(stub.config.BaseURL),
+		WithKeys(Failover, Credentials{Key: "only", Secret: "secret"}))
+	if c.keys != nil {
+		t.Error("one key enabled the rotation")
+	}
+	if _, err := c.PodcastByFeedID("1"); err != nil {
+		t.Fatal(err)
+	}
+	if key != "only" {
+		t.Errorf("X-Auth-Key = %q, want %q", key, "only")
+	}
+}
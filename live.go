@@ -0,0 +1,81 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// The states of a live item in Episode.LiveStatus
+const (
+	LiveStatusPending = "pending"
+	LiveStatusLive    = "live"
+	LiveStatusEnded   = "ended"
+)
+
+// liveItemsWindow is the number of the latest items of a feed LiveItemsForFeed
+// looks at
+const liveItemsWindow = 100
+
+// IsLive reports if the episode is a live item that is currently live
+func (e *Episode) IsLive() bool {
+	return e.LiveStatus == LiveStatusLive
+}
+
+// LiveItemsForFeed returns the live items of a podcast that are live now or
+// scheduled for the future. The API has no endpoint for this, so the latest
+// 100 items of the feed are fetched together with the live items the API lists
+// apart from them, and filtered: an item is kept when its status is live or
+// pending, or when it starts after now.
+func (c *Client) LiveItemsForFeed(ctx context.Context, feedID string) ([]*Episode, error) {
+	if err := required("feedID", feedID); err != nil {
+		return nil, err
+	}
+	url := c.newURL("episodes/byfeedid").set("id", feedID).max(liveItemsWindow).String()
+	result, err := c.getEpisodesWithMeta(ctx, url, errors.New("Could not get episodes by feed id"))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	live := make([]*Episode, 0)
+	seen := make(map[int]bool)
+	for _, e := range append(result.LiveItems, result.Items...) {
+		if e == nil || (e.ID != 0 && seen[e.ID]) {
+			continue
+		}
+		seen[e.ID] = true
+		switch {
+		case e.LiveStatus == LiveStatusLive, e.LiveStatus == LiveStatusPending:
+			live = append(live, e)
+		case e.LiveStatus != LiveStatusEnded && time.Time(e.StartTime).After(now):
+			live = append(live, e)
+		}
+	}
+	return live, nil
+}
+
+// EpisodesLive returns live items across the index that are live now or
+// scheduled, with their StartTime, EndTime and the ContentLink of the stream
+//
+// - max = number of items to return, if max is 0 the default number of items will be
+// returned
+func (c *Client) EpisodesLive(max int) ([]*Episode, error) {
+	return c.EpisodesLiveCtx(context.Background(), max)
+}
+
+// EpisodesLiveCtx works like EpisodesLive, it is canceled when ctx is done
+func (c *Client) EpisodesLiveCtx(ctx context.Context, max int) ([]*Episode, error) {
+	url := c.newURL("episodes/live").fullText().max(max).String()
+	result := &LiveEpisodesResponse{}
+	err := c.requestContext(ctx, url, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Status == "false" {
+		if err := c.listNotFound(url, result.Description, errors.New("Could not get the live episodes")); err != nil {
+			return nil, err
+		}
+		result.Items = []*Episode{}
+	}
+	return result.Items, nil
+}
@@ -0,0 +1,32 @@
+package podcastindex
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+)
+
+func TestLiveItemsForFeed(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/episodes_byfeedid_live.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newTestClient(t, respond(http.StatusOK, string(fixture)))
+	live, err := c.LiveItemsForFeed(context.Background(), "920666")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var guids []string
+	for _, e := range live {
+		guids = append(guids, e.GUID)
+	}
+	// the live item is only in liveItems, the pending one is in both lists and
+	// the ended one is left out
+	if len(guids) != 2 || guids[0] != "PC20-live-156" || guids[1] != "PC20-live-157" {
+		t.Errorf("got %v", guids)
+	}
+	if !live[0].IsLive() || live[0].ContentLink != "https://podcastindex.org/live" {
+		t.Errorf("live item = %+v", live[0])
+	}
+}
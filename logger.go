@@ -0,0 +1,39 @@
+package podcastindex
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// WithLogger logs every request to the API at debug level to logger, with the
+// endpoint, the query, the duration, the HTTP status, the error if any and the
+// rate limit headers of the response. This helps to find out why a search
+// returns nothing. A nil logger disables logging, which is the default.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+func (c *Client) logRequest(ctx context.Context, url string, start time.Time, status int, header http.Header, err error) {
+	attrs := []slog.Attr{
+		slog.String("endpoint", endpointOf(url)),
+		slog.Duration("duration", time.Since(start)),
+		slog.Int("status", status),
+	}
+	if i := strings.IndexByte(url, '?'); i >= 0 {
+		attrs = append(attrs, slog.String("query", url[i+1:]))
+	}
+	for key, values := range header {
+		if strings.HasPrefix(strings.ToLower(key), "x-ratelimit") || key == "Retry-After" {
+			attrs = append(attrs, slog.String(key, strings.Join(values, ",")))
+		}
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "podcastindex request", attrs...)
+}
@@ -0,0 +1,25 @@
+package podcastindex
+
+// Medium is the podcast:medium of a feed, it tells what kind of content the
+// feed has
+type Medium string
+
+// Values of podcast:medium
+const (
+	MediumPodcast    Medium = "podcast"
+	MediumMusic      Medium = "music"
+	MediumVideo      Medium = "video"
+	MediumFilm       Medium = "film"
+	MediumAudiobook  Medium = "audiobook"
+	MediumNewsletter Medium = "newsletter"
+	MediumBlog       Medium = "blog"
+)
+
+// MediumOrDefault returns the medium of the podcast, feeds without a
+// podcast:medium tag are podcasts
+func (p *Podcast) MediumOrDefault() Medium {
+	if p.Medium == "" {
+		return MediumPodcast
+	}
+	return p.Medium
+}
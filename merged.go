@@ -0,0 +1,208 @@
+package podcastindex
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// defaultMergedPageSize is used by MergedEpisodeIterator when pageSize is not set
+const defaultMergedPageSize = 20
+
+// MergedIterator returns the episodes of several feeds as one timeline, newest
+// first. It is created with MergedEpisodeIterator and is not safe for
+// concurrent use.
+type MergedIterator struct {
+	c        *Client
+	ctx      context.Context
+	pageSize int
+
+	started bool
+	cursors []*feedCursor
+	heads   cursorHeap
+	errs    map[string]error
+}
+
+// feedCursor is the buffered page of a single feed
+type feedCursor struct {
+	feedID  string
+	page    []*Episode
+	pos     int
+	fetched int
+	done    bool
+	seen    map[string]bool
+}
+
+func (f *feedCursor) head() *Episode {
+	return f.page[f.pos]
+}
+
+// cursorHeap keeps the cursors ordered by the publish time of their next
+// episode, the cursor with the newest episode is at the root
+type cursorHeap []*feedCursor
+
+func (h cursorHeap) Len() int { return len(h) }
+func (h cursorHeap) Less(i, j int) bool {
+	return time.Time(h[i].head().DatePublished).After(time.Time(h[j].head().DatePublished))
+}
+func (h cursorHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *cursorHeap) Push(x interface{}) { *h = append(*h, x.(*feedCursor)) }
+func (h *cursorHeap) Pop() interface{} {
+	old := *h
+	f := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return f
+}
+
+// MergedEpisodeIterator returns an iterator over the episodes of all feeds,
+// newest first across the feeds. Episodes are requested lazily in pages of
+// pageSize per feed. A pageSize of 0 or less uses 20.
+//
+// The API can not be asked for episodes before a time or from an offset, so
+// every following page of a feed requests its newest episodes again, up to the
+// end of that page, and drops the ones that were already returned. The n-th
+// page of a feed therefore transfers n*pageSize episodes, the data transferred
+// grows quadratically with the number of pages, and a feed is paged up to at
+// most MaxEpisodes episodes. Use a large pageSize to read far back in time.
+// Besides the current page the iterator keeps the GUIDs of all episodes it
+// returned of every feed, to drop them from the following pages.
+//
+// A feed that fails is left out of the timeline while the others continue, see
+// Errors.
+func (c *Client) MergedEpisodeIterator(ctx context.Context, feedIDs []string, pageSize int) *MergedIterator {
+	if pageSize <= 0 {
+		pageSize = defaultMergedPageSize
+	}
+	it := &MergedIterator{
+		c:        c,
+		ctx:      ctx,
+		pageSize: pageSize,
+		errs:     make(map[string]error),
+	}
+	for _, id := range feedIDs {
+		it.cursors = append(it.cursors, &feedCursor{feedID: id, seen: make(map[string]bool)})
+	}
+	return it
+}
+
+// Next returns the newest episode that was not returned yet. It returns false
+// when all feeds are exhausted, failed or ctx is done.
+func (it *MergedIterator) Next() (*Episode, bool) {
+	if !it.started {
+		it.start()
+	}
+	if it.ctx.Err() != nil || it.heads.Len() == 0 {
+		return nil, false
+	}
+	f := it.heads[0]
+	e := f.head()
+	f.pos++
+	if f.pos == len(f.page) && !f.done {
+		if err := it.fill(f); err != nil {
+			it.errs[f.feedID] = err
+		}
+	}
+	if f.pos < len(f.page) {
+		heap.Fix(&it.heads, 0)
+	} else {
+		heap.Pop(&it.heads)
+	}
+	return e, true
+}
+
+// Errors returns the errors of the feeds that failed, keyed by feed id. The
+// episodes of a feed returned before it failed stay valid.
+func (it *MergedIterator) Errors() map[string]error {
+	result := make(map[string]error, len(it.errs))
+	for id, err := range it.errs {
+		result[id] = err
+	}
+	return result
+}
+
+// Err returns the errors of all failed feeds and of ctx joined, or nil
+func (it *MergedIterator) Err() error {
+	errs := make([]error, 0, len(it.errs)+1)
+	for _, f := range it.cursors {
+		if err, ok := it.errs[f.feedID]; ok {
+			errs = append(errs, fmt.Errorf("feed %s: %w", f.feedID, err))
+		}
+	}
+	if err := it.ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// start requests the first page of all feeds concurrently
+func (it *MergedIterator) start() {
+	it.started = true
+	var mu sync.Mutex
+	fanOut(it.ctx, len(it.cursors), defaultConcurrency, func(i int) error {
+		f := it.cursors[i]
+		err := it.fill(f)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			it.errs[f.feedID] = err
+		}
+		return nil
+	})
+	for _, f := range it.cursors {
+		if f.pos < len(f.page) {
+			it.heads = append(it.heads, f)
+		}
+	}
+	heap.Init(&it.heads)
+}
+
+// fill replaces the page of f with the next episodes of the feed
+func (it *MergedIterator) fill(f *feedCursor) error {
+	f.page, f.pos = nil, 0
+	if err := required("feedID", f.feedID); err != nil {
+		f.done = true
+		return err
+	}
+	max := f.fetched + it.pageSize
+	if max >= MaxEpisodes {
+		max = MaxEpisodes
+		f.done = true
+	}
+	url := it.c.newURL("episodes/byfeedid").set("id", f.feedID).fullText().max(max).String()
+	episodes, err := it.c.getEpisodes(it.ctx, url, errors.New("Could not get episodes by feed id"))
+	if err != nil {
+		f.done = true
+		return err
+	}
+	if len(episodes) < max {
+		f.done = true
+	}
+	f.fetched = len(episodes)
+	sort.SliceStable(episodes, func(i, j int) bool {
+		return time.Time(episodes[i].DatePublished).After(time.Time(episodes[j].DatePublished))
+	})
+	for _, e := range episodes {
+		if e == nil {
+			continue
+		}
+		key := e.GUID
+		if key == "" {
+			key = fmt.Sprintf("id:%d", e.ID)
+		}
+		if f.seen[key] {
+			continue
+		}
+		f.seen[key] = true
+		f.page = append(f.page, e)
+	}
+	// the episodes of this page were all returned before, e.g. when new episodes
+	// were published in between, so the next page is requested right away
+	if len(f.page) == 0 && !f.done {
+		return it.fill(f)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package podcastindex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// feedServer serves episodes/byfeedid for feeds with the given publish times,
+// newest first like the API. Unknown feeds answer with status 500.
+func feedServer(t *testing.T, feeds map[string][]int64, opts ...ClientOption) (*Client, func() map[string][]int) {
+	var (
+		mu   sync.Mutex
+		maxs = make(map[string][]int)
+	)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Query().Get("id")
+		max, _ := strconv.Atoi(r.URL.Query().Get("max"))
+		mu.Lock()
+		maxs[id] = append(maxs[id], max)
+		mu.Unlock()
+		published, ok := feeds[id]
+		if !ok {
+			respond(http.StatusInternalServerError, `{}`)(w, r)
+			return
+		}
+		if max > len(published) {
+			max = len(published)
+		}
+		result := EpisodeArrayResponse{Status: "true"}
+		for _, p := range published[:max] {
+			result.Items = append(result.Items, &Episode{
+				GUID:          fmt.Sprintf("%s-%d", id, p),
+				DatePublished: Time(time.Unix(p, 0)),
+			})
+		}
+		json.NewEncoder(w).Encode(result)
+	}, opts...)
+	return c, func() map[string][]int {
+		mu.Lock()
+		defer mu.Unlock()
+		return maxs
+	}
+}
+
+func TestMergedEpisodeIterator(t *testing.T) {
+	c, maxs := feedServer(t, map[string][]int64{
+		"1": {90, 70, 50, 30, 10},
+		"2": {80, 60, 40},
+	})
+	it := c.MergedEpisodeIterator(context.Background(), []string{"1", "2"}, 2)
+	var got []string
+	for e, ok := it.Next(); ok; e, ok = it.Next() {
+		got = append(got, e.GUID)
+	}
+	want := []string{"1-90", "2-80", "1-70", "2-60", "1-50", "2-40", "1-30", "1-10"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("Err = %s", err)
+	}
+	// every page requests the feed from the top again, with a growing max
+	wantMaxs := map[string][]int{"1": {2, 4, 6}, "2": {2, 4}}
+	if got := maxs(); !reflect.DeepEqual(got, wantMaxs) {
+		t.Errorf("max per request = %v, want %v", got, wantMaxs)
+	}
+}
+
+func TestMergedEpisodeIteratorFailedFeed(t *testing.T) {
+	c, _ := feedServer(t, map[string][]int64{"1": {20, 10}})
+	it := c.MergedEpisodeIterator(context.Background(), []string{"1", "broken", ""}, 0)
+	var got []string
+	for e, ok := it.Next(); ok; e, ok = it.Next() {
+		got = append(got, e.GUID)
+	}
+	if want := []string{"1-20", "1-10"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	errs := it.Errors()
+	if len(errs) != 2 || !errors.Is(errs[""], ErrInvalidArgument) {
+		t.Errorf("Errors = %v", errs)
+	}
+	var apiErr *APIError
+	if !errors.As(errs["broken"], &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("error of the broken feed = %v", errs["broken"])
+	}
+	if it.Err() == nil {
+		t.Error("Err = nil")
+	}
+}
+
+func TestMergedEpisodeIteratorCanceled(t *testing.T) {
+	c, _ := feedServer(t, map[string][]int64{"1": {30, 20, 10}})
+	ctx, cancel := context.WithCancel(context.Background())
+	it := c.MergedEpisodeIterator(ctx, []string{"1"}, 1)
+	if _, ok := it.Next(); !ok {
+		t.Fatal("no first episode")
+	}
+	cancel()
+	if _, ok := it.Next(); ok {
+		t.Error("Next returned an episode after ctx was canceled")
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Errorf("Err = %v, want context.Canceled", it.Err())
+	}
+}
@@ -0,0 +1,45 @@
+package podcastindex
+
+import "strings"
+
+// Person is a <podcast:person> of a feed or an episode, e.g. a host or a guest
+type Person struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Role  string `json:"role"`
+	Group string `json:"group"`
+	Href  string `json:"href"`
+	Image string `json:"img"`
+}
+
+// AllPersons combines the persons of the podcast with the persons of the
+// episode, podcast first. Persons with the same name and role are only returned
+// once, an empty role counts as "host" like in the podcast namespace. p and e
+// can be nil.
+func AllPersons(p *Podcast, e *Episode) []*Person {
+	var all []*Person
+	if p != nil {
+		all = append(all, p.Persons...)
+	}
+	if e != nil {
+		all = append(all, e.Persons...)
+	}
+	seen := make(map[string]bool, len(all))
+	result := make([]*Person, 0, len(all))
+	for _, person := range all {
+		if person == nil {
+			continue
+		}
+		role := strings.ToLower(person.Role)
+		if role == "" {
+			role = "host"
+		}
+		key := strings.ToLower(strings.TrimSpace(person.Name)) + "\x00" + role
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, person)
+	}
+	return result
+}
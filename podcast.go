@@ -0,0 +1,130 @@
+package podcastindex
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// freshnessHalfLife is the age of the last update at which the recency part of
+// Freshness has dropped to one half
+const freshnessHalfLife = 30 * 24 * time.Hour
+
+// Freshness returns a heuristic score between 0 and 1 for how fresh the podcast
+// is at now. It is meant for ranking and combines two parts:
+//
+// - recency = 0.5^(age of LastUpdateTime / 30 days), weighted with 0.8
+//
+// - volume = episodeCount / (episodeCount + 10), weighted with 0.2
+//
+// A podcast updated at now with many episodes gets close to 1, one that was
+// never updated and has no episodes gets 0. Updates in the future count as now.
+func (p *Podcast) Freshness(now time.Time) float64 {
+	recency := 0.0
+	updated := time.Time(p.LastUpdateTime)
+	if !updated.IsZero() && updated.Unix() > 0 {
+		age := now.Sub(updated)
+		if age < 0 {
+			age = 0
+		}
+		recency = math.Pow(0.5, float64(age)/float64(freshnessHalfLife))
+	}
+	volume := 0.0
+	if p.EpisodeCount > 0 {
+		volume = float64(p.EpisodeCount) / float64(p.EpisodeCount+10)
+	}
+	return 0.8*recency + 0.2*volume
+}
+
+// ShareURL returns a web page for the podcast, the website of the podcast
+// (Link) is preferred over the page on podcastindex.org
+func (p *Podcast) ShareURL() string {
+	if p.Link != "" {
+		return p.Link
+	}
+	return fmt.Sprintf("%spodcast/%d", WebURL, p.ID)
+}
+
+// RefreshPodcast fetches the current information of a podcast that was
+// returned earlier and returns it as a new value, p is not changed. The
+// PodcastGUID of p is kept when the API does not return one.
+func (c *Client) RefreshPodcast(ctx context.Context, p *Podcast) (*Podcast, error) {
+	if p == nil {
+		return nil, fmt.Errorf("%w: podcast is nil", ErrInvalidArgument)
+	}
+	if err := positive("podcast id", int64(p.ID)); err != nil {
+		return nil, err
+	}
+	fresh, err := c.podcastByFeedID(ctx, fmt.Sprintf("%d", p.ID))
+	if err != nil {
+		return nil, err
+	}
+	if fresh.PodcastGUID == "" {
+		fresh.PodcastGUID = p.PodcastGUID
+	}
+	return fresh, nil
+}
+
+// PlainDescription returns the description without HTML tags and with decoded
+// entities, e.g. for previews
+func (p *Podcast) PlainDescription() string {
+	return stripHTML(p.Description)
+}
+
+// FilterImportable returns the podcasts which may be imported by other
+// platforms, it leaves out feeds locked with <podcast:locked>
+func FilterImportable(feeds []*Podcast) []*Podcast {
+	result := make([]*Podcast, 0, len(feeds))
+	for _, p := range feeds {
+		if p != nil && p.Locked == 0 {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// AverageUpdateInterval returns the mean time between the publish dates of the
+// given episodes of the podcast, e.g. to choose a polling interval. Pass the
+// recent episodes to get the current cadence. Episodes without publish date
+// are ignored and 0 is returned for fewer than two episodes.
+func (p *Podcast) AverageUpdateInterval(episodes []*Episode) time.Duration {
+	var oldest, newest time.Time
+	n := 0
+	for _, e := range episodes {
+		if e == nil {
+			continue
+		}
+		published := time.Time(e.DatePublished)
+		if published.IsZero() || published.Unix() <= 0 {
+			continue
+		}
+		if n == 0 || published.Before(oldest) {
+			oldest = published
+		}
+		if n == 0 || published.After(newest) {
+			newest = published
+		}
+		n++
+	}
+	if n < 2 {
+		return 0
+	}
+	// the gaps between sorted dates add up to the whole span
+	return newest.Sub(oldest) / time.Duration(n-1)
+}
+
+// FeedIDFromPodcastGUID returns the id of the feed in the index for a
+// podcast:guid, e.g. to move subscriptions stored by guid to ids. When the guid
+// is unknown ErrPodcastNotFound is returned.
+func (c *Client) FeedIDFromPodcastGUID(ctx context.Context, guid string) (string, error) {
+	p, err := c.podcastByFeedGUID(ctx, guid)
+	if err != nil {
+		return "", err
+	}
+	if p.ID == 0 {
+		return "", ErrPodcastNotFound
+	}
+	return strconv.FormatUint(uint64(p.ID), 10), nil
+}
@@ -0,0 +1,191 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// notFoundFeed is what podcasts/byfeedurl and podcasts/byfeedid answer for an
+// unknown podcast
+const notFoundFeed = `{"status":"true","query":{"url":"https://example.com/none.xml"},"feed":[],"description":"No feeds match this url."}`
+
+func TestIsFeedIndexed(t *testing.T) {
+	indexed, err := os.ReadFile("testdata/podcasts_byfeedid.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		body string
+		want bool
+	}{
+		{"indexed", string(indexed), true},
+		{"not indexed", notFoundFeed, false},
+		{"status false", `{"status":"false","feed":[],"description":"No feeds match this url."}`, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var feedURL string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				feedURL = r.URL.Query().Get("url")
+				respond(http.StatusOK, test.body)(w, r)
+			})
+			got, err := c.IsFeedIndexed(context.Background(), "https://example.com/feed.xml")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+			if feedURL != "https://example.com/feed.xml" {
+				t.Errorf("url = %q", feedURL)
+			}
+		})
+	}
+}
+
+func TestIsFeedIndexedError(t *testing.T) {
+	c := newTestClient(t, respond(http.StatusInternalServerError, `{}`))
+	if _, err := c.IsFeedIndexed(context.Background(), "https://example.com/feed.xml"); err == nil {
+		t.Error("no error for status 500")
+	}
+}
+
+func TestPodcastByFeedIDEmptyFeedList(t *testing.T) {
+	for _, opts := range [][]ClientOption{nil, {WithStrictDecoding(true)}} {
+		c := newTestClient(t, respond(http.StatusOK, notFoundFeed), opts...)
+		_, err := c.PodcastByFeedID("1")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("got %v, want ErrNotFound", err)
+		}
+	}
+}
+
+func TestFreshness(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	tests := []struct {
+		name     string
+		updated  time.Time
+		episodes int
+		want     float64
+	}{
+		{"never updated", time.Time{}, 0, 0},
+		{"unix zero", time.Unix(0, 0), 0, 0},
+		{"updated now", now, 0, 0.8},
+		{"one half life", now.Add(-30 * day), 10, 0.8*0.5 + 0.2*0.5},
+		{"two half lives", now.Add(-60 * day), 30, 0.8*0.25 + 0.2*0.75},
+		{"in the future", now.Add(day), 90, 0.8 + 0.2*0.9},
+		{"only episodes", time.Time{}, 10, 0.1},
+	}
+	for _, test := range tests {
+		p := &Podcast{LastUpdateTime: Time(test.updated), EpisodeCount: test.episodes}
+		if got := p.Freshness(now); math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFilterImportable(t *testing.T) {
+	feeds := []*Podcast{
+		{ID: 1, Title: "good"},
+		{ID: 2, Title: "locked", Locked: 1},
+		{ID: 3, Title: "dead", Dead: 1},
+		nil,
+		{ID: 4, Title: "parse errors", ParseErrors: 12, CrawlErrors: 3},
+		{ID: 5, Title: "dead and locked", Dead: 1, Locked: 1},
+		{ID: 6, Title: "parse errors and locked", ParseErrors: 2, Locked: 1},
+	}
+	// only the lock forbids an import, dead or broken feeds are left to the caller
+	var got []string
+	for _, p := range FilterImportable(feeds) {
+		got = append(got, p.Title)
+	}
+	want := []string{"good", "dead", "parse errors"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := FilterImportable(nil); got == nil || len(got) != 0 {
+		t.Errorf("nil: got %#v, want an empty list", got)
+	}
+}
+
+func TestAverageUpdateInterval(t *testing.T) {
+	day := 24 * time.Hour
+	start := time.Date(2024, time.January, 1, 6, 0, 0, 0, time.UTC)
+	at := func(offsets ...time.Duration) []*Episode {
+		episodes := make([]*Episode, 0, len(offsets))
+		for _, o := range offsets {
+			episodes = append(episodes, &Episode{DatePublished: Time(start.Add(o))})
+		}
+		return episodes
+	}
+	tests := []struct {
+		name     string
+		episodes []*Episode
+		want     time.Duration
+	}{
+		{"no episodes", nil, 0},
+		{"one episode", at(0), 0},
+		{"one dated episode", append(at(0), &Episode{}, nil), 0},
+		{"weekly", at(21*day, 14*day, 7*day, 0), 7 * day},
+		{"daily out of order", at(2*day, 0, 4*day, day, 3*day), day},
+		{"irregular", at(0, day, 10*day), 5 * day},
+		{"irregular with undated", append(at(0, 3*day, 4*day, 12*day), &Episode{DatePublished: Time(time.Unix(0, 0))}), 4 * day},
+	}
+	p := &Podcast{}
+	for _, test := range tests {
+		if got := p.AverageUpdateInterval(test.episodes); got != test.want {
+			t.Errorf("%s: got %s, want %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFeedIDFromPodcastGUID(t *testing.T) {
+	const guid = "917393e3-1b1e-5cef-ace4-edaa54e1f810"
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"known", `{"status":"true","feed":{"id":920666,"podcastGuid":"` + guid + `"}}`, "920666"},
+		{"status false", `{"status":"false","feed":[],"description":"No feeds match this guid."}`, ""},
+		{"empty feed list", `{"status":"true","feed":[],"description":"No feeds match this guid."}`, ""},
+		{"feed without id", `{"status":"true","feed":{}}`, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var sent string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				sent = r.URL.Query().Get("guid")
+				respond(http.StatusOK, test.body)(w, r)
+			})
+			id, err := c.FeedIDFromPodcastGUID(context.Background(), guid)
+			if sent != guid {
+				t.Errorf("guid = %q", sent)
+			}
+			if test.want == "" {
+				if !errors.Is(err, ErrPodcastNotFound) || id != "" {
+					t.Errorf("got %q, %v, want ErrPodcastNotFound", id, err)
+				}
+				return
+			}
+			if err != nil || id != test.want {
+				t.Errorf("got %q, %v, want %q", id, err, test.want)
+			}
+		})
+	}
+}
+
+func TestFeedIDFromPodcastGUIDEmpty(t *testing.T) {
+	c := NewClient("key", "secret")
+	if _, err := c.FeedIDFromPodcastGUID(context.Background(), ""); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("got %v, want ErrInvalidArgument", err)
+	}
+}
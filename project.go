@@ -0,0 +1,55 @@
+package podcastindex
+
+import (
+	"reflect"
+	"strings"
+)
+
+// The API has no field selection, it always returns complete objects. To keep
+// cached results small the projection helpers zero every field that is not
+// needed instead, so the memory of e.g. long descriptions can be freed.
+
+// ProjectPodcasts keeps only the given fields of every podcast and zeros all
+// others. Fields are named like in the API responses, e.g. "id", "title" or
+// "image".
+func ProjectPodcasts(feeds []*Podcast, fields []string) {
+	keep := fieldSet(fields)
+	for _, p := range feeds {
+		if p != nil {
+			project(reflect.ValueOf(p).Elem(), keep)
+		}
+	}
+}
+
+// ProjectEpisodes keeps only the given fields of every episode and zeros all
+// others, see ProjectPodcasts
+func ProjectEpisodes(episodes []*Episode, fields []string) {
+	keep := fieldSet(fields)
+	for _, e := range episodes {
+		if e != nil {
+			project(reflect.ValueOf(e).Elem(), keep)
+		}
+	}
+}
+
+func fieldSet(fields []string) map[string]bool {
+	keep := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		keep[f] = true
+	}
+	return keep
+}
+
+func project(v reflect.Value, keep map[string]bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" || keep[name] {
+			continue
+		}
+		f := v.Field(i)
+		if f.CanSet() {
+			f.Set(reflect.Zero(f.Type()))
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package podcastindex
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WithRateLimit limits the requests to the API to rps per second on average,
+// with bursts of up to burst requests, so concurrent callers share the limit of
+// the key instead of getting throttled by the API. Requests wait until they are
+// allowed or their context is done. An rps of 0 or less disables the limit,
+// which is the default. Resources outside of the API, like enclosures, are not
+// limited.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		if rps <= 0 {
+			c.limiter = nil
+			return
+		}
+		if burst < 1 {
+			burst = 1
+		}
+		c.limiter = &rateLimiter{
+			rate:   rps,
+			burst:  float64(burst),
+			tokens: float64(burst),
+		}
+	}
+}
+
+// rateLimiter is a token bucket which is refilled with rate tokens per second
+// up to burst tokens
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// wait blocks until a token is available and takes it, or returns the error of
+// ctx when it is done first
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		if !l.last.IsZero() {
+			l.tokens += now.Sub(l.last).Seconds() * l.rate
+			if l.tokens > l.burst {
+				l.tokens = l.burst
+			}
+		}
+		l.last = now
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+		if err := wait(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurst(t *testing.T) {
+	c := NewClient("key", "secret", WithRateLimit(1, 3))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	for i := 0; i < 3; i++ {
+		if err := c.limiter.wait(ctx); err != nil {
+			t.Fatalf("token %d of the burst: %s", i, err)
+		}
+	}
+	if err := c.limiter.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v after the burst, want the error of ctx", err)
+	}
+}
+
+func TestRateLimiterRate(t *testing.T) {
+	l := NewClient("key", "secret", WithRateLimit(50, 1)).limiter
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		if err := l.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// one token of the burst, then five at 20ms each
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("6 tokens took %s, want at least 100ms", elapsed)
+	}
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	for _, rps := range []float64{0, -1} {
+		if c := NewClient("key", "secret", WithRateLimit(rps, 5)); c.limiter != nil {
+			t.Errorf("WithRateLimit(%v) enabled the limiter", rps)
+		}
+	}
+	if l := NewClient("key", "secret", WithRateLimit(1, 0)).limiter; l.burst != 1 {
+		t.Errorf("burst = %v, want 1", l.burst)
+	}
+}
+
+func TestRateLimitedClient(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		respond(http.StatusOK, `{"status":"true","feeds":[]}`)(w, r)
+	}, WithRateLimit(0.1, 2))
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = c.SearchPodcastsWithOptionsCtx(ctx, "go", SearchOptions{})
+		}(i)
+	}
+	wg.Wait()
+	failed := 0
+	for _, err := range errs {
+		if errors.Is(err, context.DeadlineExceeded) {
+			failed++
+		}
+	}
+	if requests != 2 || failed != 2 {
+		t.Errorf("made %d requests, %d waited for ctx, want 2 and 2", requests, failed)
+	}
+}
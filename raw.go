@@ -0,0 +1,74 @@
+package podcastindex
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// RawCapture collects the undecoded bodies of the API responses of all requests
+// made with a context of ContextWithRawCapture, e.g. to read fields this
+// package does not model yet or to archive the original responses:
+//
+//	ctx, raw := podcastindex.ContextWithRawCapture(ctx)
+//	podcast, err := c.PodcastByFeedIDCtx(ctx, "75075")
+//	body := raw.Last()
+//
+// It is safe for concurrent use, so it works with the methods that make
+// several requests at once as well.
+type RawCapture struct {
+	mu        sync.Mutex
+	responses []RawResponse
+}
+
+// RawResponse is the body of a single response of the API
+type RawResponse struct {
+	// Endpoint that was called, without the query
+	Endpoint string
+	// StatusCode is the HTTP status of the response
+	StatusCode int
+	// Body is the response as the API sent it
+	Body json.RawMessage
+}
+
+type rawCaptureKey struct{}
+
+// ContextWithRawCapture returns a context that captures the responses of all
+// requests made with it into the returned RawCapture
+func ContextWithRawCapture(ctx context.Context) (context.Context, *RawCapture) {
+	r := &RawCapture{}
+	return context.WithValue(ctx, rawCaptureKey{}, r), r
+}
+
+// Responses returns the captured responses in the order they were received
+func (r *RawCapture) Responses() []RawResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]RawResponse(nil), r.responses...)
+}
+
+// Last returns the body of the last captured response, or nil when nothing was
+// captured
+func (r *RawCapture) Last() json.RawMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.responses) == 0 {
+		return nil
+	}
+	return r.responses[len(r.responses)-1].Body
+}
+
+// captureRaw adds body to the RawCapture of ctx, if it has one
+func captureRaw(ctx context.Context, url string, statusCode int, body []byte) {
+	r, ok := ctx.Value(rawCaptureKey{}).(*RawCapture)
+	if !ok {
+		return
+	}
+	r.mu.Lock()
+	r.responses = append(r.responses, RawResponse{
+		Endpoint:   endpointOf(url),
+		StatusCode: statusCode,
+		Body:       append(json.RawMessage(nil), body...),
+	})
+	r.mu.Unlock()
+}
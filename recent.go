@@ -0,0 +1,88 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type RecentDataResponse struct {
+	Status      string         `json:"status"`
+	FeedCount   int            `json:"feedCount"`
+	ItemCount   int            `json:"itemCount"`
+	Max         interface{}    `json:"max"`
+	Since       interface{}    `json:"since"`
+	Description string         `json:"description"`
+	Data        RecentDataList `json:"data"`
+	NextSince   Time           `json:"nextSince"`
+}
+
+// RecentDataList is the compact list of recently updated feeds and items
+// returned by RecentData
+type RecentDataList struct {
+	Feeds []*RecentDataFeed `json:"feeds"`
+	Items []*RecentDataItem `json:"items"`
+}
+
+// RecentDataFeed is a feed in RecentDataList
+type RecentDataFeed struct {
+	FeedID          int    `json:"feedId"`
+	FeedURL         string `json:"feedUrl"`
+	FeedTitle       string `json:"feedTitle"`
+	FeedDescription string `json:"feedDescription"`
+	FeedImage       string `json:"feedImage"`
+	FeedLanguage    string `json:"feedLanguage"`
+	FeedItunesID    int    `json:"feedItunesId"`
+}
+
+// RecentDataItem is an episode in RecentDataList, with the feed it belongs to
+type RecentDataItem struct {
+	FeedID                 int      `json:"feedId"`
+	FeedURL                string   `json:"feedUrl"`
+	FeedTitle              string   `json:"feedTitle"`
+	FeedDescription        string   `json:"feedDescription"`
+	FeedImage              string   `json:"feedImage"`
+	FeedLanguage           string   `json:"feedLanguage"`
+	FeedItunesID           int      `json:"feedItunesId"`
+	EpisodeID              int      `json:"episodeId"`
+	EpisodeTitle           string   `json:"episodeTitle"`
+	EpisodeDescription     string   `json:"episodeDescription"`
+	EpisodeImage           string   `json:"episodeImage"`
+	EpisodeTimestamp       Time     `json:"episodeTimestamp"`
+	EpisodeAdded           Time     `json:"episodeAdded"`
+	EpisodeEnclosureURL    string   `json:"episodeEnclosureUrl"`
+	EpisodeEnclosureLength int      `json:"episodeEnclosureLength"`
+	EpisodeEnclosureType   string   `json:"episodeEnclosureType"`
+	EpisodeDuration        Duration `json:"episodeDuration"`
+	EpisodeType            string   `json:"episodeType"`
+}
+
+// RecentData returns the recently updated feeds and items of the whole index
+// in one compact list, which is the way to follow all changes of the index. To
+// continue where a call stopped pass the NextSince of the response as since to
+// the next call.
+//
+// - max = number of items to return, if max is 0 the default number of items will be
+// returned
+//
+// - since = only return changes since that time. Set time to zero to use the
+// API default
+func (c *Client) RecentData(max int, since time.Time) (*RecentDataResponse, error) {
+	return c.RecentDataCtx(context.Background(), max, since)
+}
+
+// RecentDataCtx works like RecentData, it is canceled when ctx is done
+func (c *Client) RecentDataCtx(ctx context.Context, max int, since time.Time) (*RecentDataResponse, error) {
+	url := c.newURL("recent/data").max(max).since(since).String()
+	result := &RecentDataResponse{}
+	err := c.requestContext(ctx, url, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Status == "false" {
+		if err := c.listNotFound(url, result.Description, errors.New("Could not get the recent data")); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
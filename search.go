@@ -0,0 +1,153 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+)
+
+// SearchOptions are the optional parameters of a podcast search, the zero value
+// uses the API defaults
+type SearchOptions struct {
+	// Clean only returns non explicit feeds according to itunes:explicit
+	Clean bool
+	// Max is the number of results, 0 uses the API default
+	Max int
+	// AppleOnly only returns feeds that have an iTunes id, so they are listed in
+	// the Apple Podcasts catalog. The title search does not support it, so it is
+	// not sent by SearchPodcastsByTitle.
+	AppleOnly bool
+	// Similar also returns podcasts with a similar title or term, not only
+	// exact matches. The term is sent without quotes then, even when
+	// WithExactPhraseSearch is set, because the exact phrase would only match
+	// itself.
+	Similar bool
+}
+
+// SearchPodcastsWithOptions searches for podcasts, authors or owners like
+// SearchPodcasts, with the given options
+func (c *Client) SearchPodcastsWithOptions(term string, opts SearchOptions) ([]*Podcast, error) {
+	return c.SearchPodcastsWithOptionsCtx(context.Background(), term, opts)
+}
+
+// SearchPodcastsWithOptionsCtx works like SearchPodcastsWithOptions, it is
+// canceled when ctx is done
+func (c *Client) SearchPodcastsWithOptionsCtx(ctx context.Context, term string, opts SearchOptions) ([]*Podcast, error) {
+	result, err := c.searchPodcasts(ctx, "search/byterm", term, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.Feeds, nil
+}
+
+// SearchPodcastsByTitle searches for podcasts by their title only, unlike
+// SearchPodcasts it does not match authors or owners
+func (c *Client) SearchPodcastsByTitle(title string) ([]*Podcast, error) {
+	return c.SearchPodcastsByTitleCtx(context.Background(), title)
+}
+
+// SearchPodcastsByTitleCtx works like SearchPodcastsByTitle, it is canceled
+// when ctx is done
+func (c *Client) SearchPodcastsByTitleCtx(ctx context.Context, title string) ([]*Podcast, error) {
+	return c.SearchPodcastsByTitleWithOptionsCtx(ctx, title, SearchOptions{})
+}
+
+// SearchPodcastsByTitleWithOptions works like SearchPodcastsByTitle, with the
+// given options
+func (c *Client) SearchPodcastsByTitleWithOptions(title string, opts SearchOptions) ([]*Podcast, error) {
+	return c.SearchPodcastsByTitleWithOptionsCtx(context.Background(), title, opts)
+}
+
+// SearchPodcastsByTitleWithOptionsCtx works like
+// SearchPodcastsByTitleWithOptions, it is canceled when ctx is done
+func (c *Client) SearchPodcastsByTitleWithOptionsCtx(ctx context.Context, title string, opts SearchOptions) ([]*Podcast, error) {
+	result, err := c.searchPodcasts(ctx, "search/bytitle", title, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.Feeds, nil
+}
+
+// SearchMusic searches for feeds with the medium music, see MediumMusic. Use
+// the zero SearchOptions for the defaults of the API.
+func (c *Client) SearchMusic(term string, opts SearchOptions) ([]*Podcast, error) {
+	return c.SearchMusicCtx(context.Background(), term, opts)
+}
+
+// SearchMusicCtx works like SearchMusic, it is canceled when ctx is done
+func (c *Client) SearchMusicCtx(ctx context.Context, term string, opts SearchOptions) ([]*Podcast, error) {
+	result, err := c.searchPodcasts(ctx, "search/music/byterm", term, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.Feeds, nil
+}
+
+func (c *Client) searchPodcasts(ctx context.Context, endpoint, term string, opts SearchOptions) (*PodcastArrayResult, error) {
+	if err := required("term", term); err != nil {
+		return nil, err
+	}
+	u := c.newURL(endpoint).fullText().valueFilter().flag("clean", opts.Clean).
+		flag("similar", opts.Similar).max(opts.Max)
+	if opts.Similar {
+		u.set("q", term)
+	} else {
+		u.term(term)
+	}
+	if opts.AppleOnly && endpoint != "search/bytitle" {
+		u.set("aponly", "true")
+	}
+	url := u.String()
+	result := &PodcastArrayResult{}
+	err := c.requestContext(ctx, url, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Status == "false" {
+		if err := c.listNotFound(url, result.Description, errors.New("Could not find a podcast for that term")); err != nil {
+			return nil, err
+		}
+		result.Feeds = []*Podcast{}
+	}
+	return result, nil
+}
+
+// EpisodeSearchOptions are the parameters of an episode search by person, see
+// SearchEpisodes. Besides Term the zero value uses the API defaults.
+type EpisodeSearchOptions struct {
+	// Term is the person to search for, it is required
+	Term string
+	// FullText returns the descriptions in full instead of truncated to 100
+	// characters, unless the client is configured otherwise, see WithFullText
+	FullText bool
+	// Clean only returns non explicit episodes according to itunes:explicit
+	Clean bool
+	// Max is the number of results, 0 uses the API default
+	Max int
+}
+
+// SearchEpisodesWithOptions searches for episodes where a person is mentioned
+// like SearchEpisodes, with the given options
+func (c *Client) SearchEpisodesWithOptions(opts EpisodeSearchOptions) ([]*Episode, error) {
+	return c.SearchEpisodesWithOptionsCtx(context.Background(), opts)
+}
+
+// SearchEpisodesWithOptionsCtx works like SearchEpisodesWithOptions, it is
+// canceled when ctx is done
+func (c *Client) SearchEpisodesWithOptionsCtx(ctx context.Context, opts EpisodeSearchOptions) ([]*Episode, error) {
+	result, err := c.searchEpisodes(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
+
+func (c *Client) searchEpisodes(ctx context.Context, opts EpisodeSearchOptions) (*EpisodeArrayResponse, error) {
+	if err := required("term", opts.Term); err != nil {
+		return nil, err
+	}
+	u := c.newURL("search/byperson").term(opts.Term).flag("clean", opts.Clean).max(opts.Max)
+	if opts.FullText {
+		u.fullText()
+	}
+	return c.getEpisodesWithMeta(ctx, u.String(), errors.New("Could not find a episode for that term"))
+}
@@ -0,0 +1,101 @@
+package podcastindex
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestSearchQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ClientOption
+		call func(c *Client) error
+		path string
+		want url.Values
+	}{
+		{
+			name: "clean and val",
+			opts: []ClientOption{WithValue(ValueLightning)},
+			call: func(c *Client) error {
+				_, err := c.SearchPodcastsWithOptions("bitcoin", SearchOptions{Clean: true, Max: 5})
+				return err
+			},
+			path: "/search/byterm",
+			want: url.Values{"q": {`"bitcoin"`}, "clean": {""}, "val": {"lightning"}, "max": {"5"}, "fulltext": {""}},
+		},
+		{
+			name: "val without clean",
+			opts: []ClientOption{WithValue(ValueHive)},
+			call: func(c *Client) error {
+				_, err := c.SearchPodcasts("hive")
+				return err
+			},
+			path: "/search/byterm",
+			want: url.Values{"q": {`"hive"`}, "val": {"hive"}, "fulltext": {""}},
+		},
+		{
+			name: "aponly on byterm",
+			call: func(c *Client) error {
+				_, err := c.SearchPodcastsWithOptions("go time", SearchOptions{AppleOnly: true})
+				return err
+			},
+			path: "/search/byterm",
+			want: url.Values{"q": {`"go time"`}, "aponly": {"true"}, "fulltext": {""}},
+		},
+		{
+			name: "aponly with clean and max",
+			call: func(c *Client) error {
+				_, err := c.SearchPodcastsWithOptions("go time", SearchOptions{AppleOnly: true, Clean: true, Max: 2})
+				return err
+			},
+			path: "/search/byterm",
+			want: url.Values{"q": {`"go time"`}, "aponly": {"true"}, "clean": {""}, "max": {"2"}, "fulltext": {""}},
+		},
+		{
+			name: "title search without aponly",
+			call: func(c *Client) error {
+				_, err := c.SearchPodcastsByTitleWithOptions("go time", SearchOptions{AppleOnly: true, Clean: true})
+				return err
+			},
+			path: "/search/bytitle",
+			want: url.Values{"q": {`"go time"`}, "clean": {""}, "fulltext": {""}},
+		},
+		{
+			name: "similar is unquoted",
+			call: func(c *Client) error {
+				_, err := c.SearchPodcastsWithOptions("go time", SearchOptions{Similar: true})
+				return err
+			},
+			path: "/search/byterm",
+			want: url.Values{"q": {"go time"}, "similar": {""}, "fulltext": {""}},
+		},
+		{
+			name: "music",
+			call: func(c *Client) error {
+				_, err := c.SearchMusic("jazz", SearchOptions{Max: 3})
+				return err
+			},
+			path: "/search/music/byterm",
+			want: url.Values{"q": {`"jazz"`}, "max": {"3"}, "fulltext": {""}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got *url.URL
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				got = r.URL
+				respond(http.StatusOK, `{"status":"true","feeds":[]}`)(w, r)
+			}, test.opts...)
+			if err := test.call(c); err != nil {
+				t.Fatal(err)
+			}
+			if got.Path != test.path {
+				t.Errorf("path = %q, want %q", got.Path, test.path)
+			}
+			if query := got.Query(); query.Encode() != test.want.Encode() {
+				t.Errorf("query = %v, want %v", query, test.want)
+			}
+		})
+	}
+}
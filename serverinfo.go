@@ -0,0 +1,79 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+// ErrVersionUnknown is returned by ServerInfo when the API sent no version
+// header
+var ErrVersionUnknown = errors.New("API did not report a version")
+
+// versionHeaders are checked in this order for the version of the API. The API
+// does not document a version header, these are the common names used by
+// deployments and proxies that send one. The Server header is left out, it
+// names the web server or CDN, not the API.
+var versionHeaders = []string{"X-Api-Version", "X-Version"}
+
+// serverInfo keeps the version the API reported in its first response
+type serverInfo struct {
+	mu      sync.Mutex
+	version string
+	// seen is set by the first response, so a missing version is not
+	// requested again
+	seen bool
+}
+
+func (s *serverInfo) record(header http.Header) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seen = true
+	if s.version != "" {
+		return
+	}
+	for _, name := range versionHeaders {
+		if v := header.Get(name); v != "" {
+			s.version = v
+			return
+		}
+	}
+}
+
+func (s *serverInfo) get() (version string, seen bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.version, s.seen
+}
+
+// ServerInfo returns the version or build the API reported, useful to log which
+// deployment the client talks to. It is taken from the response headers of the
+// first request and cached. When no request was made yet, stats/current is
+// requested to get it. ErrVersionUnknown is returned when the API does not
+// report its version, which the public API does not document, so expect it
+// unless a deployment or proxy sends one of the version headers.
+func (c *Client) ServerInfo() (version string, err error) {
+	return c.ServerInfoCtx(context.Background())
+}
+
+// ServerInfoCtx works like ServerInfo, it is canceled when ctx is done
+func (c *Client) ServerInfoCtx(ctx context.Context) (version string, err error) {
+	if v, seen := c.server.get(); seen {
+		return v, versionErr(v)
+	}
+	var ignored interface{}
+	if err := c.requestContext(ctx, c.newURL("stats/current").String(), &ignored); err != nil {
+		return "", err
+	}
+	v, _ := c.server.get()
+	return v, versionErr(v)
+}
+
+// versionErr returns ErrVersionUnknown for an empty version
+func versionErr(version string) error {
+	if version == "" {
+		return ErrVersionUnknown
+	}
+	return nil
+}
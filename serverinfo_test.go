@@ -0,0 +1,83 @@
+package podcastindex
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestServerInfo(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  map[string]string
+		version string
+		err     error
+	}{
+		{"api version", map[string]string{"X-Api-Version": "1.2.3", "X-Version": "9", "Server": "nginx"}, "1.2.3", nil},
+		{"version", map[string]string{"X-Version": "build-42", "Server": "cloudflare"}, "build-42", nil},
+		{"only the web server", map[string]string{"Server": "cloudflare"}, "", ErrVersionUnknown},
+		{"no header", nil, "", ErrVersionUnknown},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				for name, value := range test.header {
+					w.Header().Set(name, value)
+				}
+				respond(http.StatusOK, `{"status":"true","stats":{}}`)(w, r)
+			})
+			version, err := c.ServerInfo()
+			if version != test.version || !errors.Is(err, test.err) || (test.err == nil && err != nil) {
+				t.Errorf("got %q, %v, want %q, %v", version, err, test.version, test.err)
+			}
+		})
+	}
+}
+
+func TestServerInfoCached(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		err     error
+	}{
+		{"version", "1.2.3", nil},
+		{"no version", "", ErrVersionUnknown},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := 0
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if test.version != "" {
+					w.Header().Set("X-Api-Version", test.version)
+				}
+				respond(http.StatusOK, `{"status":"true","stats":{}}`)(w, r)
+			})
+			for i := 0; i < 3; i++ {
+				if version, err := c.ServerInfo(); version != test.version || !errors.Is(err, test.err) || (test.err == nil && err != nil) {
+					t.Fatalf("call %d: got %q, %v", i, version, err)
+				}
+			}
+			if requests != 1 {
+				t.Errorf("3 calls made %d requests, want 1", requests)
+			}
+		})
+	}
+}
+
+func TestServerInfoAfterRequest(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		respond(http.StatusOK, `{"status":"true","feeds":[]}`)(w, r)
+	})
+	if _, err := c.SearchPodcasts("go"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ServerInfo(); !errors.Is(err, ErrVersionUnknown) {
+		t.Errorf("got %v, want ErrVersionUnknown", err)
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests, want 1", requests)
+	}
+}
@@ -0,0 +1,103 @@
+package podcastindex
+
+import (
+	"context"
+	"time"
+)
+
+// PodcastService holds the read methods of Client, so applications can depend
+// on it instead of *Client and replace the client with a fake in their tests
+type PodcastService interface {
+	SearchPodcasts(term string) ([]*Podcast, error)
+	SearchPodcastsCtx(ctx context.Context, term string) ([]*Podcast, error)
+	SearchPodcastsC(term string, clean bool, max int) ([]*Podcast, error)
+	SearchPodcastsWithOptions(term string, opts SearchOptions) ([]*Podcast, error)
+	SearchPodcastsWithOptionsCtx(ctx context.Context, term string, opts SearchOptions) ([]*Podcast, error)
+	SearchPodcastsByTitle(title string) ([]*Podcast, error)
+	SearchPodcastsByTitleCtx(ctx context.Context, title string) ([]*Podcast, error)
+	SearchPodcastsByTitleWithOptions(title string, opts SearchOptions) ([]*Podcast, error)
+	SearchPodcastsByTitleWithOptionsCtx(ctx context.Context, title string, opts SearchOptions) ([]*Podcast, error)
+	SearchMusic(term string, opts SearchOptions) ([]*Podcast, error)
+	SearchMusicCtx(ctx context.Context, term string, opts SearchOptions) ([]*Podcast, error)
+	SearchEpisodes(term string) ([]*Episode, error)
+	SearchEpisodesCtx(ctx context.Context, term string) ([]*Episode, error)
+	SearchEpisodesWithOptions(opts EpisodeSearchOptions) ([]*Episode, error)
+	SearchEpisodesWithOptionsCtx(ctx context.Context, opts EpisodeSearchOptions) ([]*Episode, error)
+
+	PodcastByFeedURL(url string) (*Podcast, error)
+	PodcastByFeedURLCtx(ctx context.Context, url string) (*Podcast, error)
+	PodcastByFeedID(id string) (*Podcast, error)
+	PodcastByFeedIDCtx(ctx context.Context, id string) (*Podcast, error)
+	PodcastByITunesID(id string) (*Podcast, error)
+	PodcastByITunesIDCtx(ctx context.Context, id string) (*Podcast, error)
+	PodcastByFeedGUID(guid string) (*Podcast, error)
+	PodcastByFeedGUIDCtx(ctx context.Context, guid string) (*Podcast, error)
+	PodcastByAppleURL(ctx context.Context, appleURL string) (*Podcast, error)
+	PodcastsTrending(languages, categories, notCategories []string, max int, since time.Time) ([]*Podcast, error)
+	PodcastsTrendingCtx(ctx context.Context, languages, categories, notCategories []string, max int, since time.Time) ([]*Podcast, error)
+	PodcastsTrendingWithOptions(opts TrendingOptions) ([]*Podcast, error)
+	PodcastsTrendingWithOptionsCtx(ctx context.Context, opts TrendingOptions) ([]*Podcast, error)
+	PodcastsByMedium(medium Medium, max int) ([]*Podcast, error)
+	PodcastsByMediumCtx(ctx context.Context, medium Medium, max int) ([]*Podcast, error)
+	DeadPodcasts() ([]*DeadPodcast, error)
+	DeadPodcastsCtx(ctx context.Context) ([]*DeadPodcast, error)
+	RecentPodcasts(languages, categories, notCategories []string, max int, since time.Time) ([]*RecentPodcast, error)
+	RecentPodcastsCtx(ctx context.Context, languages, categories, notCategories []string, max int, since time.Time) ([]*RecentPodcast, error)
+	RecentNewValueFeeds() ([]*NewValueFeed, error)
+	RecentNewValueFeedsCtx(ctx context.Context) ([]*NewValueFeed, error)
+	NewPodcasts() ([]*NewPodcast, error)
+	NewPodcastsCtx(ctx context.Context) ([]*NewPodcast, error)
+	NewPodcastsC(max int, since time.Time, feedID int) ([]*NewPodcast, error)
+	NewPodcastsWithOptions(opts NewPodcastsOptions) ([]*NewPodcast, error)
+	NewPodcastsWithOptionsCtx(ctx context.Context, opts NewPodcastsOptions) ([]*NewPodcast, error)
+	RandomPodcasts(languages, categories, notCategories []string, medium Medium, max int) ([]*Podcast, error)
+	RandomPodcastsCtx(ctx context.Context, languages, categories, notCategories []string, medium Medium, max int) ([]*Podcast, error)
+
+	EpisodesByFeedID(id string, max int, since time.Time) ([]*Episode, error)
+	EpisodesByFeedIDCtx(ctx context.Context, id string, max int, since time.Time) ([]*Episode, error)
+	EpisodesByFeedIDWithOptions(id string, opts EpisodeOptions) ([]*Episode, error)
+	EpisodesByFeedIDWithOptionsCtx(ctx context.Context, id string, opts EpisodeOptions) ([]*Episode, error)
+	EpisodesByFeedIDs(ids []int64, max int, since time.Time) ([]*Episode, error)
+	EpisodesByFeedIDsCtx(ctx context.Context, ids []int64, max int, since time.Time) ([]*Episode, error)
+	EpisodesByFeedURL(feedURL string, max int, since time.Time) ([]*Episode, error)
+	EpisodesByFeedURLCtx(ctx context.Context, feedURL string, max int, since time.Time) ([]*Episode, error)
+	EpisodesByFeedURLWithOptions(feedURL string, opts EpisodeOptions) ([]*Episode, error)
+	EpisodesByFeedURLWithOptionsCtx(ctx context.Context, feedURL string, opts EpisodeOptions) ([]*Episode, error)
+	EpisodesByITunesID(id string, max int, since time.Time) ([]*Episode, error)
+	EpisodesByITunesIDCtx(ctx context.Context, id string, max int, since time.Time) ([]*Episode, error)
+	EpisodesByITunesIDWithOptions(id string, opts EpisodeOptions) ([]*Episode, error)
+	EpisodesByITunesIDWithOptionsCtx(ctx context.Context, id string, opts EpisodeOptions) ([]*Episode, error)
+	EpisodeByID(id string) (*Episode, error)
+	EpisodeByIDCtx(ctx context.Context, id string) (*Episode, error)
+	RandomEpisodes(languages, categories, notCategories []string, max int) ([]*Episode, error)
+	RandomEpisodesCtx(ctx context.Context, languages, categories, notCategories []string, max int) ([]*Episode, error)
+	RecentData(max int, since time.Time) (*RecentDataResponse, error)
+	RecentDataCtx(ctx context.Context, max int, since time.Time) (*RecentDataResponse, error)
+	RecentSoundbites(max int) ([]*RecentSoundbite, error)
+	RecentSoundbitesCtx(ctx context.Context, max int) ([]*RecentSoundbite, error)
+	RecentEpisodes(before int, max int, exclude string) ([]*Episode, error)
+	RecentEpisodesCtx(ctx context.Context, before int, max int, exclude string) ([]*Episode, error)
+	RecentEpisodesWithOptions(opts RecentEpisodesOptions) ([]*Episode, error)
+	RecentEpisodesWithOptionsCtx(ctx context.Context, opts RecentEpisodesOptions) ([]*Episode, error)
+	EpisodesLive(max int) ([]*Episode, error)
+	EpisodesLiveCtx(ctx context.Context, max int) ([]*Episode, error)
+	EpisodesTrending(languages, categories []string, max int, since time.Time) ([]*Episode, error)
+	EpisodesTrendingCtx(ctx context.Context, languages, categories []string, max int, since time.Time) ([]*Episode, error)
+
+	ValueByFeedID(id int64) (*Value, error)
+	ValueByFeedIDCtx(ctx context.Context, id int64) (*Value, error)
+	ValueByFeedURL(feedURL string) (*Value, error)
+	ValueByFeedURLCtx(ctx context.Context, feedURL string) (*Value, error)
+	ValueByPodcastGUID(guid string) (*Value, error)
+	ValueByPodcastGUIDCtx(ctx context.Context, guid string) (*Value, error)
+	ValuesByFeedIDs(ids []int64) (map[int64]*Value, error)
+	ValuesByFeedIDsCtx(ctx context.Context, ids []int64) (map[int64]*Value, error)
+
+	Categories() ([]*Category, error)
+	CategoriesCtx(ctx context.Context) ([]*Category, error)
+	CachedCategories(ctx context.Context) ([]*Category, error)
+	StatsCurrent() (*Stats, error)
+	StatsCurrentCtx(ctx context.Context) (*Stats, error)
+}
+
+var _ PodcastService = (*Client)(nil)
@@ -0,0 +1,65 @@
+package podcastindex
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ParseShareURL returns the feed id and, if present, the episode id of a
+// podcastindex.org URL as created by ShareURL, like
+// https://podcastindex.org/podcast/75075?episode=16795090. The episode id can
+// also be a path segment: https://podcastindex.org/podcast/75075/16795090.
+// episodeID is empty for podcast URLs.
+func ParseShareURL(shareURL string) (feedID, episodeID string, err error) {
+	u, err := url.Parse(strings.TrimSpace(shareURL))
+	if err != nil {
+		return "", "", err
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	web, _ := url.Parse(WebURL)
+	if host != web.Hostname() {
+		return "", "", fmt.Errorf("%w: %q is not a podcastindex.org URL", ErrInvalidArgument, shareURL)
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) < 2 || len(segments) > 3 || segments[0] != "podcast" || !isNumber(segments[1]) {
+		return "", "", fmt.Errorf("%w: unknown share URL %q", ErrInvalidArgument, shareURL)
+	}
+	feedID = segments[1]
+	episodeID = u.Query().Get("episode")
+	if len(segments) == 3 {
+		episodeID = segments[2]
+	}
+	if episodeID != "" && !isNumber(episodeID) {
+		return "", "", fmt.Errorf("%w: unknown share URL %q", ErrInvalidArgument, shareURL)
+	}
+	return feedID, episodeID, nil
+}
+
+// ResolveShareURL fetches the podcast and, for episode URLs, the episode of a
+// podcastindex.org URL, see ParseShareURL. The episode is nil for podcast URLs.
+func (c *Client) ResolveShareURL(ctx context.Context, shareURL string) (*Podcast, *Episode, error) {
+	feedID, episodeID, err := ParseShareURL(shareURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	podcast, err := c.podcastByFeedID(ctx, feedID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if episodeID == "" {
+		return podcast, nil, nil
+	}
+	episode, err := c.episodeByID(ctx, episodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return podcast, episode, nil
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
+}
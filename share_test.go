@@ -0,0 +1,86 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// shareServer serves podcasts/byfeedid and episodes/byid for any id and counts
+// the requests per endpoint
+func shareServer(t *testing.T) (*Client, map[string]int) {
+	requests := make(map[string]int)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		id := r.URL.Query().Get("id")
+		switch r.URL.Path {
+		case "/podcasts/byfeedid":
+			respond(http.StatusOK, fmt.Sprintf(`{"status":"true","feed":{"id":%s}}`, id))(w, r)
+		case "/episodes/byid":
+			respond(http.StatusOK, fmt.Sprintf(`{"status":"true","episode":{"id":%s,"feedId":75075}}`, id))(w, r)
+		default:
+			respond(http.StatusNotFound, `{}`)(w, r)
+		}
+	})
+	return c, requests
+}
+
+func TestResolveShareURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		episodeID int
+	}{
+		{"podcast", "https://podcastindex.org/podcast/75075", 0},
+		{"podcast with www and slash", "https://www.podcastindex.org/podcast/75075/", 0},
+		{"episode query", "https://podcastindex.org/podcast/75075?episode=16795090", 16795090},
+		{"episode path", "https://podcastindex.org/podcast/75075/16795090", 16795090},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, requests := shareServer(t)
+			podcast, episode, err := c.ResolveShareURL(context.Background(), test.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if podcast == nil || podcast.ID != 75075 {
+				t.Errorf("podcast = %+v", podcast)
+			}
+			switch {
+			case test.episodeID == 0 && episode != nil:
+				t.Errorf("podcast URL returned episode %+v", episode)
+			case test.episodeID != 0 && (episode == nil || episode.ID != test.episodeID):
+				t.Errorf("episode = %+v, want id %d", episode, test.episodeID)
+			}
+			wantEpisodes := 0
+			if test.episodeID != 0 {
+				wantEpisodes = 1
+			}
+			if requests["/podcasts/byfeedid"] != 1 || requests["/episodes/byid"] != wantEpisodes {
+				t.Errorf("requests = %v", requests)
+			}
+		})
+	}
+}
+
+func TestResolveShareURLInvalid(t *testing.T) {
+	for _, u := range []string{
+		"",
+		"https://example.com/podcast/75075",
+		"https://podcastindex.org/",
+		"https://podcastindex.org/podcast/abc",
+		"https://podcastindex.org/episode/75075",
+		"https://podcastindex.org/podcast/75075?episode=abc",
+		"https://podcastindex.org/podcast/75075/1/2",
+	} {
+		c, requests := shareServer(t)
+		if _, _, err := c.ResolveShareURL(context.Background(), u); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("%q: got %v, want ErrInvalidArgument", u, err)
+		}
+		if len(requests) != 0 {
+			t.Errorf("%q: made requests %v", u, requests)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package podcastindex
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// SortField is a field podcasts can be sorted by, see SortPodcasts
+type SortField int
+
+const (
+	// SortByTitle sorts by title, ignoring case
+	SortByTitle SortField = iota
+	// SortByLastUpdate sorts by the time the podcast was last updated
+	SortByLastUpdate
+	// SortByEpisodeCount sorts by the number of episodes
+	SortByEpisodeCount
+)
+
+// SortPodcasts sorts feeds in place by the given field, ascending unless desc is
+// set. The sort is stable and podcasts missing the field, e.g. an empty title
+// or no update time, as well as nil entries always come last.
+func SortPodcasts(feeds []*Podcast, by SortField, desc bool) {
+	less := func(a, b *Podcast) bool {
+		switch by {
+		case SortByLastUpdate:
+			return time.Time(a.LastUpdateTime).Before(time.Time(b.LastUpdateTime))
+		case SortByEpisodeCount:
+			return a.EpisodeCount < b.EpisodeCount
+		default:
+			return strings.ToLower(a.Title) < strings.ToLower(b.Title)
+		}
+	}
+	missing := func(p *Podcast) bool {
+		if p == nil {
+			return true
+		}
+		switch by {
+		case SortByLastUpdate:
+			return time.Time(p.LastUpdateTime).IsZero() || time.Time(p.LastUpdateTime).Unix() == 0
+		case SortByEpisodeCount:
+			return false
+		default:
+			return p.Title == ""
+		}
+	}
+	sort.SliceStable(feeds, func(i, j int) bool {
+		a, b := feeds[i], feeds[j]
+		if missing(a) || missing(b) {
+			return !missing(a) && missing(b)
+		}
+		if desc {
+			return less(b, a)
+		}
+		return less(a, b)
+	})
+}
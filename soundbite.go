@@ -0,0 +1,78 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+)
+
+// Soundbite is a short part of an episode, marked by <podcast:soundbite>.
+// StartTime and Duration are in seconds and can be fractional.
+type Soundbite struct {
+	StartTime float64 `json:"startTime"`
+	Duration  float64 `json:"duration"`
+	Title     string  `json:"title"`
+}
+
+// StartOffset returns the position of the soundbite in the episode
+func (s *Soundbite) StartOffset() time.Duration {
+	return secondsToDuration(s.StartTime)
+}
+
+// Length returns how long the soundbite is
+func (s *Soundbite) Length() time.Duration {
+	return secondsToDuration(s.Duration)
+}
+
+// secondsToDuration rounds to the nearest millisecond to not carry floating
+// point noise, e.g. 12.3 seconds, into the duration
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Round(seconds*1000)) * time.Millisecond
+}
+
+type RecentSoundbitesResponse struct {
+	Status      string             `json:"status"`
+	Items       []*RecentSoundbite `json:"items"`
+	Count       int                `json:"count"`
+	Description string             `json:"description"`
+}
+
+// RecentSoundbite is a soundbite together with the episode and the feed it is
+// part of
+type RecentSoundbite struct {
+	Soundbite
+	EnclosureURL string `json:"enclosureUrl"`
+	EpisodeID    int    `json:"episodeId"`
+	EpisodeTitle string `json:"episodeTitle"`
+	FeedID       int    `json:"feedId"`
+	FeedTitle    string `json:"feedTitle"`
+	FeedURL      string `json:"feedUrl"`
+}
+
+// RecentSoundbites returns the soundbites that were added to the index most
+// recently
+//
+// - max = number of soundbites to return, if max is 0 the default number of soundbites will be
+// returned
+func (c *Client) RecentSoundbites(max int) ([]*RecentSoundbite, error) {
+	return c.RecentSoundbitesCtx(context.Background(), max)
+}
+
+// RecentSoundbitesCtx works like RecentSoundbites, it is canceled when ctx is
+// done
+func (c *Client) RecentSoundbitesCtx(ctx context.Context, max int) ([]*RecentSoundbite, error) {
+	url := c.newURL("recent/soundbites").max(max).String()
+	result := &RecentSoundbitesResponse{}
+	err := c.requestContext(ctx, url, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Status == "false" {
+		if err := c.listNotFound(url, result.Description, errors.New("Could not get the recent soundbites")); err != nil {
+			return nil, err
+		}
+		result.Items = []*RecentSoundbite{}
+	}
+	return result.Items, nil
+}
@@ -0,0 +1,32 @@
+package podcastindex
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestSoundbiteDurations(t *testing.T) {
+	tests := []struct {
+		json          string
+		start, length time.Duration
+	}{
+		{`{"startTime":12.5,"duration":30,"title":"intro"}`, 12500 * time.Millisecond, 30 * time.Second},
+		{`{"startTime":1234.3,"duration":0.1}`, 1234300 * time.Millisecond, 100 * time.Millisecond},
+		{`{"startTime":0.0005,"duration":59.9999}`, time.Millisecond, 60 * time.Second},
+		{`{"startTime":73,"duration":60}`, 73 * time.Second, time.Minute},
+		{`{}`, 0, 0},
+	}
+	for _, test := range tests {
+		var s Soundbite
+		if err := json.Unmarshal([]byte(test.json), &s); err != nil {
+			t.Fatalf("%s: %s", test.json, err)
+		}
+		if got := s.StartOffset(); got != test.start {
+			t.Errorf("%s: StartOffset = %s, want %s", test.json, got, test.start)
+		}
+		if got := s.Length(); got != test.length {
+			t.Errorf("%s: Length = %s, want %s", test.json, got, test.length)
+		}
+	}
+}
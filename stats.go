@@ -0,0 +1,47 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+)
+
+type StatsResponse struct {
+	Status      string `json:"status"`
+	Stats       *Stats `json:"stats"`
+	Description string `json:"description"`
+}
+
+// Stats are the current numbers of the whole index. The FeedsWithNewEpisodes
+// fields count the feeds with at least one episode published in the last 3,
+// 10, 30 and 90 days.
+type Stats struct {
+	FeedCountTotal             int `json:"feedCountTotal"`
+	EpisodeCountTotal          int `json:"episodeCountTotal"`
+	FeedsWithNewEpisodes3Days  int `json:"feedsWithNewEpisodes3days"`
+	FeedsWithNewEpisodes10Days int `json:"feedsWithNewEpisodes10days"`
+	FeedsWithNewEpisodes30Days int `json:"feedsWithNewEpisodes30days"`
+	FeedsWithNewEpisodes90Days int `json:"feedsWithNewEpisodes90days"`
+	FeedsWithValueBlocks       int `json:"feedsWithValueBlocks"`
+}
+
+// StatsCurrent returns the current numbers of the index
+func (c *Client) StatsCurrent() (*Stats, error) {
+	return c.StatsCurrentCtx(context.Background())
+}
+
+// StatsCurrentCtx works like StatsCurrent, it is canceled when ctx is done
+func (c *Client) StatsCurrentCtx(ctx context.Context) (*Stats, error) {
+	url := c.newURL("stats/current").String()
+	result := &StatsResponse{}
+	err := c.requestContext(ctx, url, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Status == "false" {
+		return nil, newStatusError(url, result.Description, errors.New("Could not get the stats"))
+	}
+	if result.Stats == nil {
+		return nil, errors.New("API returned no stats")
+	}
+	return result.Stats, nil
+}
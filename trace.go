@@ -0,0 +1,41 @@
+package podcastindex
+
+import "context"
+
+// Tracer creates spans around the requests to the API. It is an interface so
+// tracing libraries like OpenTelemetry can be plugged in without this package
+// depending on them. The tracer is taken from the context of the request, see
+// ContextWithTracer.
+type Tracer interface {
+	// StartSpan starts a span for a request to endpoint, which is the path of
+	// the request without the query
+	StartSpan(ctx context.Context, endpoint string) (context.Context, Span)
+}
+
+// Span is a single traced request
+type Span interface {
+	// End finishes the span. statusCode is the HTTP status of the response or 0
+	// when no response was received, err is the error returned to the caller.
+	End(statusCode int, err error)
+}
+
+type tracerKey struct{}
+
+// ContextWithTracer returns a context that traces all requests made with it
+func ContextWithTracer(ctx context.Context, t Tracer) context.Context {
+	return context.WithValue(ctx, tracerKey{}, t)
+}
+
+type noopSpan struct{}
+
+func (noopSpan) End(int, error) {}
+
+// startSpan starts a span with the tracer of ctx, without a tracer it does
+// nothing
+func startSpan(ctx context.Context, endpoint string) (context.Context, Span) {
+	t, ok := ctx.Value(tracerKey{}).(Tracer)
+	if !ok || t == nil {
+		return ctx, noopSpan{}
+	}
+	return t.StartSpan(ctx, endpoint)
+}
@@ -0,0 +1,90 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// Transcript is a <podcast:transcript> of an episode
+type Transcript struct {
+	URL  string `json:"url"`
+	Type string `json:"type"`
+}
+
+// transcriptTypes is the order in which transcript formats are picked when the
+// preferred one is not available
+var transcriptTypes = []string{
+	"text/vtt",
+	"application/json",
+	"application/srt",
+	"application/x-subrip",
+	"text/html",
+	"text/plain",
+}
+
+// EpisodeTranscript fetches a transcript of the episode and returns its content
+// and type. When the episode has a transcript of type preferType it is used,
+// otherwise the first available of text/vtt, application/json, SRT, HTML and
+// plain text, in this order. Episodes that only have a TranscriptURL return
+// the content type the server reported.
+func (c *Client) EpisodeTranscript(ctx context.Context, e *Episode, preferType string) (string, string, error) {
+	if e == nil {
+		return "", "", fmt.Errorf("%w: episode is nil", ErrInvalidArgument)
+	}
+	t := pickTranscript(e, preferType)
+	if t == nil {
+		return "", "", errors.New("Episode has no transcript")
+	}
+	res, err := c.fetch(ctx, t.URL, nil)
+	if err != nil {
+		return "", "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", "", errors.New("Could not fetch transcript: " + res.Status)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", "", err
+	}
+	typ := t.Type
+	if typ == "" {
+		typ, _, _ = mime.ParseMediaType(res.Header.Get("Content-Type"))
+	}
+	return string(body), typ, nil
+}
+
+func pickTranscript(e *Episode, preferType string) *Transcript {
+	byType := make(map[string]*Transcript, len(e.Transcripts))
+	for _, t := range e.Transcripts {
+		if t == nil || t.URL == "" {
+			continue
+		}
+		typ := strings.ToLower(t.Type)
+		if _, ok := byType[typ]; !ok {
+			byType[typ] = t
+		}
+	}
+	if t, ok := byType[strings.ToLower(preferType)]; ok && preferType != "" {
+		return t
+	}
+	for _, typ := range transcriptTypes {
+		if t, ok := byType[typ]; ok {
+			return t
+		}
+	}
+	for _, t := range e.Transcripts {
+		if t != nil && t.URL != "" {
+			return t
+		}
+	}
+	if e.TranscriptURL != "" {
+		return &Transcript{URL: e.TranscriptURL}
+	}
+	return nil
+}
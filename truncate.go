@@ -0,0 +1,47 @@
+package podcastindex
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// The API can only return all descriptions in full or all truncated to 100
+// characters, see WithFullText. The helpers below truncate feeds and episodes
+// separately, e.g. to keep feed descriptions long but episode snippets short.
+
+// TruncateDescriptions shortens the description of every podcast to at most
+// maxLen runes, see truncate. A maxLen of 0 or less keeps the descriptions.
+func TruncateDescriptions(feeds []*Podcast, maxLen int) {
+	for _, p := range feeds {
+		if p != nil {
+			p.Description = truncate(p.Description, maxLen)
+		}
+	}
+}
+
+// TruncateEpisodeDescriptions shortens the description of every episode to at
+// most maxLen runes, see TruncateDescriptions
+func TruncateEpisodeDescriptions(episodes []*Episode, maxLen int) {
+	for _, e := range episodes {
+		if e != nil {
+			e.Description = truncate(e.Description, maxLen)
+		}
+	}
+}
+
+// truncate cuts s after maxLen-1 runes and adds an ellipsis, so the result has
+// at most maxLen runes. Runes are never split. s is returned as is when it is
+// short enough or maxLen is 0 or less.
+func truncate(s string, maxLen int) string {
+	if maxLen <= 0 || utf8.RuneCountInString(s) <= maxLen {
+		return s
+	}
+	n := 0
+	for i := range s {
+		if n == maxLen-1 {
+			return strings.TrimRight(s[:i], " \t\r\n") + "…"
+		}
+		n++
+	}
+	return s
+}
@@ -0,0 +1,64 @@
+package podcastindex
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		in     string
+		maxLen int
+		want   string
+	}{
+		{"short", 10, "short"},
+		{"exactly", 7, "exactly"},
+		{"too long", 5, "too…"},
+		{"Grüße aus Köln", 6, "Grüße…"},
+		{"日本語のポッドキャスト", 4, "日本語…"},
+		{"🎙️🎧 emoji podcast", 3, "🎙️…"},
+		{"Ça va très bien", 1, "…"},
+		{"anything", 0, "anything"},
+		{"anything", -1, "anything"},
+		{"", 3, ""},
+	}
+	for _, test := range tests {
+		got := truncate(test.in, test.maxLen)
+		if got != test.want {
+			t.Errorf("truncate(%q, %d) = %q, want %q", test.in, test.maxLen, got, test.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("truncate(%q, %d) split a rune: %q", test.in, test.maxLen, got)
+		}
+		if test.maxLen > 0 && utf8.RuneCountInString(got) > test.maxLen {
+			t.Errorf("truncate(%q, %d) has %d runes", test.in, test.maxLen, utf8.RuneCountInString(got))
+		}
+	}
+}
+
+func TestTruncateEveryLength(t *testing.T) {
+	s := "Épisode spécial: 日本語 – 🎙️ live aus Zürich"
+	for maxLen := 1; maxLen <= utf8.RuneCountInString(s)+1; maxLen++ {
+		got := truncate(s, maxLen)
+		if !utf8.ValidString(got) {
+			t.Fatalf("maxLen %d split a rune: %q", maxLen, got)
+		}
+		if !strings.HasPrefix(s, strings.TrimSuffix(got, "…")) {
+			t.Fatalf("maxLen %d: %q is not a prefix of the description", maxLen, got)
+		}
+	}
+}
+
+func TestTruncateDescriptions(t *testing.T) {
+	feeds := []*Podcast{{Description: "Ein Podcast über Bücher"}, nil}
+	episodes := []*Episode{{Description: "Folge über Kaffee und Kuchen"}, nil}
+	TruncateDescriptions(feeds, 12)
+	TruncateEpisodeDescriptions(episodes, 6)
+	if feeds[0].Description != "Ein Podcast…" {
+		t.Errorf("podcast: got %q", feeds[0].Description)
+	}
+	if episodes[0].Description != "Folge…" {
+		t.Errorf("episode: got %q", episodes[0].Description)
+	}
+}
@@ -1,49 +1,168 @@
 package podcastindex
 
 import (
-	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
 
-func addMax(max int) string {
-	if max != 0 {
-		return fmt.Sprintf("&max=%d", max)
+// joinURL joins the base URL and the path of an endpoint with exactly one slash,
+// no matter if base ends with one or path starts with one. url.ResolveReference
+// is not used, because it drops the last segment of a base without trailing
+// slash, e.g. for "http://localhost/api/1.0".
+func joinURL(base, path string) string {
+	if base == "" {
+		return path
 	}
-	return ""
+	return strings.TrimRight(base, "/") + "/" + strings.TrimLeft(path, "/")
 }
 
-func addBefore(before int) string {
-	if before != 0 {
-		return fmt.Sprintf("&before=%d", before)
+// urlBuilder builds the path and query of an API request. All values are
+// escaped, zero values are left out so the API defaults apply.
+type urlBuilder struct {
+	path       string
+	values     url.Values
+	defaultMax int
+
+	// fulltext is set for endpoints that return descriptions, it is only
+	// sent when withFullText is set as well
+	fulltext     bool
+	withFullText bool
+	pretty       bool
+	anyWords     bool
+	value        ValueType
+}
+
+func newURL(path string) *urlBuilder {
+	return &urlBuilder{path: path, values: url.Values{}}
+}
+
+// newURL returns a builder using the defaults configured for c
+func (c *Client) newURL(path string) *urlBuilder {
+	u := newURL(path)
+	u.defaultMax = c.defaultMax
+	u.withFullText = !c.truncateDescriptions
+	u.pretty = c.pretty
+	u.anyWords = c.anyWords
+	u.value = c.value
+	return u
+}
+
+// fullText marks the endpoint as returning descriptions, which are sent in full
+// unless the client is configured otherwise, see WithFullText
+func (u *urlBuilder) fullText() *urlBuilder {
+	u.fulltext = true
+	return u
+}
+
+// valueFilter marks the endpoint as supporting the val filter, which is sent
+// when the client is configured with WithValue
+func (u *urlBuilder) valueFilter() *urlBuilder {
+	return u.set("val", string(u.value))
+}
+
+// set adds a string parameter, empty values are left out
+func (u *urlBuilder) set(key, value string) *urlBuilder {
+	if value != "" {
+		u.values.Set(key, value)
+	}
+	return u
+}
+
+// quoted adds a string parameter wrapped in quotes, so the API searches for the
+// exact phrase. Quotes in value are removed, because they would end the phrase
+// early.
+func (u *urlBuilder) quoted(key, value string) *urlBuilder {
+	value = strings.TrimSpace(strings.ReplaceAll(value, `"`, ""))
+	if value != "" {
+		u.values.Set(key, `"`+value+`"`)
 	}
-	return ""
+	return u
 }
 
-func addExclude(exclude string) string {
-	if len(exclude) != 0 {
-		return fmt.Sprintf("&excludeString=%s", exclude)
+// term adds the search term as q, quoted unless the client is configured
+// otherwise, see WithExactPhraseSearch
+func (u *urlBuilder) term(term string) *urlBuilder {
+	if u.anyWords {
+		return u.set("q", term)
 	}
-	return ""
+	return u.quoted("q", term)
 }
 
-func addClean(clean bool) string {
-	if clean {
-		return "&clean"
+// int adds a numeric parameter, 0 is left out
+func (u *urlBuilder) int(key string, value int) *urlBuilder {
+	if value != 0 {
+		u.values.Set(key, strconv.Itoa(value))
 	}
-	return ""
+	return u
 }
 
-func addTime(t time.Time) string {
-	if t.IsZero() {
-		return ""
+// flag adds a parameter without a value, like fulltext or clean, when on is set
+func (u *urlBuilder) flag(key string, on bool) *urlBuilder {
+	if on {
+		u.values.Set(key, "")
 	}
-	return fmt.Sprintf("&since=%d", t.Unix())
+	return u
 }
 
-func addFilter(name string, filter []string) string {
-	if len(filter) == 0 {
-		return ""
+// time adds t as unix timestamp, the zero time is left out
+func (u *urlBuilder) time(key string, t time.Time) *urlBuilder {
+	if !t.IsZero() {
+		u.values.Set(key, strconv.FormatInt(t.Unix(), 10))
+	}
+	return u
+}
+
+// list adds the values separated by comma, an empty list is left out
+func (u *urlBuilder) list(key string, values []string) *urlBuilder {
+	if len(values) != 0 {
+		u.values.Set(key, strings.Join(values, ","))
+	}
+	return u
+}
+
+// max adds the max parameter, see int. When max is 0 the default of the client
+// is used, see WithDefaultMax.
+func (u *urlBuilder) max(max int) *urlBuilder {
+	if max == 0 {
+		max = u.defaultMax
+	}
+	return u.int("max", max)
+}
+
+// since adds the since parameter, see time
+func (u *urlBuilder) since(t time.Time) *urlBuilder {
+	return u.time("since", t)
+}
+
+// String returns the path with the escaped query. Parameters are sorted by key
+// and flags are written without "=".
+func (u *urlBuilder) String() string {
+	u.flag("fulltext", u.fulltext && u.withFullText)
+	u.flag("pretty", u.pretty)
+	if len(u.values) == 0 {
+		return u.path
+	}
+	keys := make([]string, 0, len(u.values))
+	for key := range u.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString(u.path)
+	for i, key := range keys {
+		if i == 0 {
+			b.WriteByte('?')
+		} else {
+			b.WriteByte('&')
+		}
+		b.WriteString(url.QueryEscape(key))
+		if value := u.values.Get(key); value != "" {
+			b.WriteByte('=')
+			b.WriteString(url.QueryEscape(value))
+		}
 	}
-	return fmt.Sprintf("&%s=%s", name, strings.Join(filter, ","))
+	return b.String()
 }
@@ -0,0 +1,98 @@
+package podcastindex
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestURLBuilder(t *testing.T) {
+	since := time.Unix(1700000000, 0)
+	tests := []struct {
+		name string
+		url  *urlBuilder
+		want string
+	}{
+		{"no parameters", newURL("search/byterm"), "search/byterm"},
+		{"empty values are left out", newURL("recent/episodes").set("q", "").int("max", 0).
+			list("cat", nil).time("since", time.Time{}).flag("clean", false), "recent/episodes"},
+		{"sorted keys", newURL("podcasts/trending").int("max", 5).set("lang", "en"),
+			"podcasts/trending?lang=en&max=5"},
+		{"flags without value", newURL("search/byterm").set("q", "go").flag("clean", true),
+			"search/byterm?clean&q=go"},
+		{"since as unix time", newURL("recent/feeds").since(since), "recent/feeds?since=1700000000"},
+		{"list joined by comma", newURL("recent/feeds").list("cat", []string{"News", "Tech"}),
+			"recent/feeds?cat=News%2CTech"},
+		{"quoted term", newURL("search/byterm").term("golang weekly"),
+			"search/byterm?q=%22golang+weekly%22"},
+		{"full text", newURL("episodes/byfeedid").fullText(), "episodes/byfeedid"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.url.String(); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestURLBuilderClientDefaults(t *testing.T) {
+	c := NewClient("key", "secret", WithDefaultMax(7), WithValue(ValueLightning))
+	got := c.newURL("podcasts/trending").max(0).valueFilter().fullText().String()
+	want := "podcasts/trending?fulltext&max=7&val=lightning"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := c.newURL("podcasts/trending").max(3).String(); got != "podcasts/trending?max=3" {
+		t.Errorf("explicit max: got %q", got)
+	}
+}
+
+func TestURLBuilderHostileInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		term  string
+		wantQ string
+	}{
+		{"ampersand", "news&max=1000", `"news&max=1000"`},
+		{"hash", "c# weekly", `"c# weekly"`},
+		{"question mark", "why?id=1", `"why?id=1"`},
+		{"percent", "100% true", `"100% true"`},
+		{"plus", "a+b", `"a+b"`},
+		{"inner quotes", `say "hi" now`, `"say hi now"`},
+		{"unicode", "café ☕", `"café ☕"`},
+		{"newline", "line\nbreak", "\"line\nbreak\""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			raw := newURL("search/byterm").term(test.term).flag("clean", true).String()
+			u, err := url.Parse(raw)
+			if err != nil {
+				t.Fatalf("could not parse %q: %s", raw, err)
+			}
+			query := u.Query()
+			if len(query) != 2 {
+				t.Errorf("%q injected parameters: %v", raw, query)
+			}
+			if got := query.Get("q"); got != test.wantQ {
+				t.Errorf("q = %q, want %q", got, test.wantQ)
+			}
+		})
+	}
+}
+
+func TestJoinURL(t *testing.T) {
+	tests := []struct {
+		base, path, want string
+	}{
+		{"https://api.podcastindex.org/api/1.0/", "search/byterm", "https://api.podcastindex.org/api/1.0/search/byterm"},
+		{"https://api.podcastindex.org/api/1.0", "/search/byterm", "https://api.podcastindex.org/api/1.0/search/byterm"},
+		{"http://localhost:8080/api/1.0//", "//stats/current", "http://localhost:8080/api/1.0/stats/current"},
+		{"", "stats/current", "stats/current"},
+	}
+	for _, test := range tests {
+		if got := joinURL(test.base, test.path); got != test.want {
+			t.Errorf("joinURL(%q, %q) = %q, want %q", test.base, test.path, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+)
+
+// Value is the value block of a podcast, it describes how listeners can send
+// payments, e.g. streaming sats over lightning, to the destinations
+type Value struct {
+	Model        ValueModel          `json:"model"`
+	Destinations []*ValueDestination `json:"destinations"`
+}
+
+// ValueModel describes the payment type and method of a value block
+type ValueModel struct {
+	Type      string `json:"type"`
+	Method    string `json:"method"`
+	Suggested string `json:"suggested"`
+}
+
+// ValueDestination is a recipient of a value block, Split is its share of the
+// payments
+type ValueDestination struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Address     string `json:"address"`
+	Split       int    `json:"split"`
+	Fee         bool   `json:"fee"`
+	CustomKey   string `json:"customKey"`
+	CustomValue string `json:"customValue"`
+}
+
+// ValueType is the payment type of a value block, see ValueModel.Type
+type ValueType string
+
+// Value types the API can filter by, see WithValue
+const (
+	ValueLightning       ValueType = "lightning"
+	ValueHive            ValueType = "hive"
+	ValueWebMonetization ValueType = "webmonetization"
+)
+
+type ValueResponse struct {
+	Status      string `json:"status"`
+	Value       *Value `json:"value"`
+	Description string `json:"description"`
+}
+
+// getValue returns nil without an error when the podcast has no value block
+func (c *Client) getValue(ctx context.Context, url string) (*Value, error) {
+	result := &ValueResponse{}
+	err := c.requestContext(ctx, url, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Status == "false" {
+		return nil, nil
+	}
+	return result.Value, nil
+}
+
+// ValueByFeedID returns the value block of the podcast with the given id. When
+// the podcast has no value block nil is returned without an error.
+func (c *Client) ValueByFeedID(id int64) (*Value, error) {
+	return c.ValueByFeedIDCtx(context.Background(), id)
+}
+
+// ValueByFeedIDCtx works like ValueByFeedID, it is canceled when ctx is done
+func (c *Client) ValueByFeedIDCtx(ctx context.Context, id int64) (*Value, error) {
+	if err := positive("id", id); err != nil {
+		return nil, err
+	}
+	return c.valueByFeedID(ctx, strconv.FormatInt(id, 10))
+}
+
+func (c *Client) valueByFeedID(ctx context.Context, id string) (*Value, error) {
+	if err := required("id", id); err != nil {
+		return nil, err
+	}
+	url := c.newURL("value/byfeedid").set("id", id).String()
+	return c.getValue(ctx, url)
+}
+
+// ValueByFeedURL returns the value block of the podcast with the given feed URL,
+// see ValueByFeedID
+func (c *Client) ValueByFeedURL(feedURL string) (*Value, error) {
+	return c.ValueByFeedURLCtx(context.Background(), feedURL)
+}
+
+// ValueByFeedURLCtx works like ValueByFeedURL, it is canceled when ctx is done
+func (c *Client) ValueByFeedURLCtx(ctx context.Context, feedURL string) (*Value, error) {
+	if err := required("feedURL", feedURL); err != nil {
+		return nil, err
+	}
+	url := c.newURL("value/byfeedurl").set("url", feedURL).String()
+	return c.getValue(ctx, url)
+}
+
+// ValueByPodcastGUID returns the value block of the podcast with the given
+// podcast:guid, see ValueByFeedID
+func (c *Client) ValueByPodcastGUID(guid string) (*Value, error) {
+	return c.ValueByPodcastGUIDCtx(context.Background(), guid)
+}
+
+// ValueByPodcastGUIDCtx works like ValueByPodcastGUID, it is canceled when ctx
+// is done
+func (c *Client) ValueByPodcastGUIDCtx(ctx context.Context, guid string) (*Value, error) {
+	if err := required("guid", guid); err != nil {
+		return nil, err
+	}
+	url := c.newURL("value/bypodcastguid").set("guid", guid).String()
+	return c.getValue(ctx, url)
+}
+
+// PodcastWithValue fetches the podcast and its value block concurrently. When
+// the podcast has no value block the returned value is nil, without an error.
+// When one of the requests fails the other one is canceled and the first error
+// is returned.
+func (c *Client) PodcastWithValue(ctx context.Context, feedID string) (*Podcast, *Value, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		podcast  *Podcast
+		value    *Value
+		firstErr error
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		var err error
+		if podcast, err = c.podcastByFeedID(ctx, feedID); err != nil {
+			fail(err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		var err error
+		if value, err = c.valueByFeedID(ctx, feedID); err != nil {
+			fail(err)
+		}
+	}()
+	wg.Wait()
+	if firstErr != nil {
+		return nil, nil, firstErr
+	}
+	return podcast, value, nil
+}
+
+type NewValueFeedsResponse struct {
+	Status      string          `json:"status"`
+	Feeds       []*NewValueFeed `json:"feeds"`
+	Count       int             `json:"count"`
+	Max         interface{}     `json:"max"`
+	Since       interface{}     `json:"since"`
+	Description string          `json:"description"`
+}
+
+// NewValueFeed is a feed which recently added a value block
+type NewValueFeed struct {
+	ID                    int             `json:"id"`
+	URL                   string          `json:"url"`
+	Title                 string          `json:"title"`
+	Author                string          `json:"author"`
+	Image                 string          `json:"image"`
+	NewestItemPublishTime Time            `json:"newestItemPublishTime"`
+	ItunesID              int             `json:"itunesId"`
+	TrendScore            int             `json:"trendScore"`
+	Language              string          `json:"language"`
+	Categories            map[uint]string `json:"categories"`
+}
+
+// RecentNewValueFeeds returns the feeds that added a value block most recently,
+// e.g. to find podcasts that can be boosted
+func (c *Client) RecentNewValueFeeds() ([]*NewValueFeed, error) {
+	return c.RecentNewValueFeedsCtx(context.Background())
+}
+
+// RecentNewValueFeedsCtx works like RecentNewValueFeeds, it is canceled when
+// ctx is done
+func (c *Client) RecentNewValueFeedsCtx(ctx context.Context) ([]*NewValueFeed, error) {
+	url := c.newURL("recent/newvaluefeeds").String()
+	result := &NewValueFeedsResponse{}
+	err := c.requestContext(ctx, url, result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Status == "false" {
+		if err := c.listNotFound(url, result.Description, errors.New("Could not get the new value feeds")); err != nil {
+			return nil, err
+		}
+		result.Feeds = []*NewValueFeed{}
+	}
+	return result.Feeds, nil
+}
@@ -0,0 +1,57 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPodcastWithValue(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/podcasts/byfeedid":
+			respond(http.StatusOK, `{"status":"true","feed":{"id":920666}}`)(w, r)
+		case "/value/byfeedid":
+			respond(http.StatusOK, `{"status":"true","value":{"model":{"type":"lightning","method":"keysend"}}}`)(w, r)
+		}
+	})
+	podcast, value, err := c.PodcastWithValue(context.Background(), "920666")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if podcast.ID != 920666 || value == nil || value.Model.Type != string(ValueLightning) {
+		t.Errorf("got %+v, %+v", podcast, value)
+	}
+}
+
+func TestPodcastWithValueCancelsOnError(t *testing.T) {
+	canceled := make(chan struct{})
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/podcasts/byfeedid":
+			respond(http.StatusInternalServerError, `{}`)(w, r)
+		case "/value/byfeedid":
+			select {
+			case <-r.Context().Done():
+				close(canceled)
+			case <-time.After(5 * time.Second):
+			}
+		}
+	})
+	start := time.Now()
+	_, _, err := c.PodcastWithValue(context.Background(), "1")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("got %v, want the error of the podcast request", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("waited %s for the value request", elapsed)
+	}
+	select {
+	case <-canceled:
+	case <-time.After(2 * time.Second):
+		t.Error("the value request was not canceled")
+	}
+}